@@ -0,0 +1,79 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// ErrMinAge is the error that returns when a birthdate is less than the minimum age ago.
+var ErrMinAge = NewError("validation_min_age", "must be at least {{.years}} years old")
+
+// MinAgeRule is a validation rule that checks if a birthdate is at least a number of years ago.
+type MinAgeRule struct {
+	years int
+	err   Error
+}
+
+// MinAge returns a validation rule that checks if a time.Time value, taken as a birthdate, is at least
+// the given number of years before the current time, i.e. the person is at least that old. For example,
+//
+//	err := validation.Validate(user.Birthdate, validation.MinAge(18))
+//
+// The age is computed from the date portion of the birthdate with the current time in the same location.
+// A person born on February 29 reaches a new age on March 1 in non-leap years.
+// When validating with ValidateWithContext, the current time is taken from the clock set by WithClock, if any.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func MinAge(years int) MinAgeRule {
+	return MinAgeRule{
+		years: years,
+		err:   ErrMinAge.SetParams(map[string]interface{}{"years": years}),
+	}
+}
+
+// Error sets the error message for the rule.
+func (r MinAgeRule) Error(message string) MinAgeRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r MinAgeRule) ErrorObject(err Error) MinAgeRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r MinAgeRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(context.Background(), value)
+}
+
+// ValidateWithContext checks if the given value is valid or not using the clock carried by the context.
+func (r MinAgeRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	birthdate, ok := value.(time.Time)
+	if !ok {
+		return fmt.Errorf("cannot convert %v to time.Time", reflect.TypeOf(value))
+	}
+	if ageAt(birthdate, now(ctx)) < r.years {
+		return r.err
+	}
+	return nil
+}
+
+// ageAt returns the age in full years of a person born at the given birthdate at the given time.
+func ageAt(birthdate, t time.Time) int {
+	t = t.In(birthdate.Location())
+	by, bm, bd := birthdate.Date()
+	ty, tm, td := t.Date()
+	age := ty - by
+	if tm < bm || tm == bm && td < bd {
+		age--
+	}
+	return age
+}
@@ -0,0 +1,68 @@
+package validation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinAge(t *testing.T) {
+	date := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	}
+	var tm *time.Time
+
+	tests := []struct {
+		tag       string
+		now       time.Time
+		birthdate interface{}
+		err       string
+	}{
+		{"t1", date(2024, 6, 15), date(2006, 6, 15), ""},
+		{"t2", date(2024, 6, 14), date(2006, 6, 15), "must be at least 18 years old"},
+		{"t3", date(2024, 6, 15), date(2000, 1, 1), ""},
+		{"t4", date(2024, 6, 15), date(2010, 1, 1), "must be at least 18 years old"},
+		// leap-year birthdays
+		{"t5", date(2022, 2, 28), date(2004, 2, 29), "must be at least 18 years old"},
+		{"t6", date(2022, 3, 1), date(2004, 2, 29), ""},
+		{"t7", date(2024, 2, 28), date(2006, 2, 28), ""},
+		{"t8", date(2024, 2, 29), date(2006, 3, 1), "must be at least 18 years old"},
+		// the time of day and location of the current time do not affect the day of the birthday
+		{"t9", time.Date(2024, 6, 14, 23, 0, 0, 0, time.FixedZone("UTC-2", -2*60*60)), date(2006, 6, 15), ""},
+		{"t10", date(2024, 6, 15), tm, ""},
+		{"t11", date(2024, 6, 15), time.Time{}, ""},
+		{"t12", date(2024, 6, 15), "2000-01-01", "cannot convert string to time.Time"},
+	}
+
+	for _, test := range tests {
+		clock := test.now
+		ctx := WithClock(context.Background(), func() time.Time { return clock })
+		err := ValidateWithContext(ctx, test.birthdate, MinAge(18))
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestMinAge_Now(t *testing.T) {
+	assert.Nil(t, MinAge(18).Validate(time.Now().AddDate(-18, 0, 0)))
+	assert.NotNil(t, MinAge(18).Validate(time.Now().AddDate(-18, 0, 1)))
+}
+
+func Test_MinAgeRule_Error(t *testing.T) {
+	r := MinAge(21)
+	assert.Equal(t, "must be at least {{.years}} years old", r.err.Message())
+	assert.Equal(t, map[string]interface{}{"years": 21}, r.err.Params())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestMinAgeRule_ErrorObject(t *testing.T) {
+	r := MinAge(18)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,90 @@
+package validation
+
+import (
+	"context"
+	"strings"
+)
+
+// ErrAndInvalid is the error that returns when a value fails several of the rules combined by And with All.
+// The messages of the errors returned by the rules, joined by "; ", are available as the "message" parameter.
+var ErrAndInvalid = NewError("validation_and_invalid", "{{.message}}")
+
+// AndRule is a validation rule that passes if all of the given rules pass.
+type AndRule struct {
+	rules []Rule
+	all   bool
+	err   Error
+}
+
+// And returns a validation rule that passes if all of the given rules pass. By default, it stops at the first
+// rule that fails and returns its error, like a list of rules does. Call All to run every rule instead, so that
+// all the violations of a value are reported at once:
+//
+//	err := validation.Validate("ab!", validation.And(validation.Length(5, 20), is.Alphanumeric).All())
+//	fmt.Println(err)
+//	// the length must be between 5 and 20; must contain English letters and digits only
+//
+// An internal error returned by a rule stops the validation and is returned as is.
+func And(rules ...Rule) AndRule {
+	return AndRule{
+		rules: rules,
+		err:   ErrAndInvalid,
+	}
+}
+
+// All makes the rule run every rule regardless of the earlier failures. If a single rule fails, its error
+// is returned as is. If several rules fail, the returned error lists all their messages.
+func (r AndRule) All() AndRule {
+	r.all = true
+	return r
+}
+
+// Error sets the error message that is used when several rules fail.
+func (r AndRule) Error(message string) AndRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct that is used when several rules fail.
+func (r AndRule) ErrorObject(err Error) AndRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r AndRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(context.Background(), value)
+}
+
+// ValidateWithContext checks if the given value is valid or not.
+// The context is passed to the rules that implement RuleWithContext.
+func (r AndRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	var errs []error
+	for _, rule := range r.rules {
+		var err error
+		if rc, ok := rule.(RuleWithContext); ok && ctx != nil {
+			err = rc.ValidateWithContext(ctx, value)
+		} else {
+			err = rule.Validate(value)
+		}
+		if err == nil {
+			continue
+		}
+		if ie, ok := err.(InternalError); ok && ie.InternalError() != nil || !r.all {
+			return err
+		}
+		errs = append(errs, err)
+	}
+
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	}
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = err.Error()
+	}
+	return r.err.SetParams(map[string]interface{}{"message": strings.Join(messages, "; ")})
+}
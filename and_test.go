@@ -0,0 +1,72 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnd(t *testing.T) {
+	internal := By(func(interface{}) error { return NewInternalError(errors.New("abc")) })
+	tests := []struct {
+		tag   string
+		rule  Rule
+		value interface{}
+		err   string
+	}{
+		{"t1", And(Length(2, 5), In("abc")), "abc", ""},
+		{"t2", And(Length(5, 10), In("xyz")), "abc", "the length must be between 5 and 10"},
+		{"t3", And(Length(5, 10), In("xyz")).All(), "abc", "the length must be between 5 and 10; must be a valid value"},
+		{"t4", And(Length(2, 5), In("xyz")).All(), "abc", "must be a valid value"},
+		{"t5", And(Required, Length(2, 5)).All(), "", "cannot be blank"},
+		{"t6", And(In("xyz"), internal, Length(5, 10)).All(), "abc", "abc"},
+		{"t7", And().All(), "abc", ""},
+		{"t8", And(Length(5, 10), And(In("xyz"), Length(1, 2)).All()).All(), "abc",
+			"the length must be between 5 and 10; must be a valid value; the length must be between 1 and 2"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := And(Length(5, 10), In("xyz")).All().Validate("abc")
+	if assert.IsType(t, ErrorObject{}, err) {
+		assert.Equal(t, "validation_and_invalid", err.(ErrorObject).Code())
+	}
+	err = And(Length(2, 5), In("xyz")).All().Validate("abc")
+	assert.Equal(t, ErrInInvalid, err)
+
+	s := struct{ Name string }{"abc"}
+	err = ValidateStruct(&s, Field(&s.Name, And(Length(5, 10), In("xyz")).All()))
+	assert.EqualError(t, err, "Name: the length must be between 5 and 10; must be a valid value.")
+}
+
+func TestAnd_ValidateWithContext(t *testing.T) {
+	type key struct{}
+	rule := WithContext(func(ctx context.Context, value interface{}) error {
+		if ctx.Value(key{}) == value {
+			return nil
+		}
+		return errors.New("unexpected value")
+	})
+	ctx := context.WithValue(context.Background(), key{}, "abc")
+
+	err := ValidateWithContext(ctx, "abc", And(In("x"), rule).All())
+	assert.EqualError(t, err, "must be a valid value")
+	err = ValidateWithContext(ctx, "xyz", And(In("x"), rule).All())
+	assert.EqualError(t, err, "must be a valid value; unexpected value")
+}
+
+func TestAndRule_Error(t *testing.T) {
+	r := And(In("a"))
+	assert.Equal(t, "{{.message}}", r.err.Message())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+	assert.Equal(t, err, r.err)
+}
@@ -0,0 +1,58 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+)
+
+// ErrAssignableInvalid is the error that returns when a value is not assignable to a type.
+var ErrAssignableInvalid = NewError("validation_assignable_invalid", "type mismatch: expected {{.type}}")
+
+// AssignableRule is a validation rule that checks if a value is assignable to a type.
+type AssignableRule struct {
+	typ reflect.Type
+	err Error
+}
+
+// AssignableTo returns a validation rule that checks if the type of a value is assignable to the given type,
+// so that a loosely-typed value, such as one decoded into an interface{}, can be assigned without a panic. For example,
+//
+//	err := validation.Validate(input["count"], validation.AssignableTo(reflect.TypeOf(int64(0))))
+//
+// Typed nil values, such as a nil *User, are checked like other values, while a nil interface is considered valid.
+// Use the Required or NotNil rule to make sure a value is not nil.
+// If the type is nil, an InternalError is returned when validating.
+func AssignableTo(t reflect.Type) AssignableRule {
+	r := AssignableRule{typ: t, err: ErrAssignableInvalid}
+	if t != nil {
+		r.err = r.err.SetParams(map[string]interface{}{"type": t.String()})
+	}
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r AssignableRule) Error(message string) AssignableRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r AssignableRule) ErrorObject(err Error) AssignableRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r AssignableRule) Validate(value interface{}) error {
+	if r.typ == nil {
+		return NewInternalError(errors.New("the type must not be nil"))
+	}
+	if value == nil {
+		return nil
+	}
+
+	if reflect.TypeOf(value).AssignableTo(r.typ) {
+		return nil
+	}
+	return r.err
+}
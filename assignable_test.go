@@ -0,0 +1,52 @@
+package validation
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssignableTo(t *testing.T) {
+	var buf *bytes.Buffer
+	var reader io.Reader
+	type myInt int64
+	tests := []struct {
+		tag   string
+		typ   reflect.Type
+		value interface{}
+		err   string
+	}{
+		{"t1", reflect.TypeOf(int64(0)), int64(1), ""},
+		{"t2", reflect.TypeOf(int64(0)), 1, "type mismatch: expected int64"},
+		{"t3", reflect.TypeOf(int64(0)), myInt(1), "type mismatch: expected int64"},
+		{"t4", reflect.TypeOf(int64(0)), nil, ""},
+		{"t5", reflect.TypeOf((*io.Reader)(nil)).Elem(), &bytes.Buffer{}, ""},
+		{"t6", reflect.TypeOf((*io.Reader)(nil)).Elem(), "abc", "type mismatch: expected io.Reader"},
+		{"t7", reflect.TypeOf((*io.Reader)(nil)).Elem(), reader, ""},
+		{"t8", reflect.TypeOf(buf), buf, ""},
+		{"t9", reflect.TypeOf(""), buf, "type mismatch: expected string"},
+		{"t10", reflect.TypeOf([]string{}), []string{"a"}, ""},
+		{"t11", reflect.TypeOf([]string{}), []interface{}{"a"}, "type mismatch: expected []string"},
+		{"t12", reflect.TypeOf(map[string]interface{}{}), map[string]interface{}{}, ""},
+	}
+
+	for _, test := range tests {
+		err := AssignableTo(test.typ).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	assert.Equal(t, NewInternalError(errors.New("the type must not be nil")), AssignableTo(nil).Validate(1))
+}
+
+func TestAssignableRule_Error(t *testing.T) {
+	r := AssignableTo(reflect.TypeOf("")).Error("{{.type}} expected")
+	assert.EqualError(t, r.Validate(1), "string expected")
+
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+	assert.Equal(t, err, r.err)
+}
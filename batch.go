@@ -0,0 +1,167 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// BatchLookupFunc looks up several values at once in an external source, such as a database, with the given context.
+// It returns whether each value exists, indexed by the value, or an error if the lookup fails.
+type BatchLookupFunc func(ctx context.Context, values []interface{}) (map[interface{}]bool, error)
+
+// batchRule is implemented by the rules that can check all the elements of an iterable with a single lookup.
+type batchRule interface {
+	Rule
+	// resolve looks up the given values and returns a copy of the rule that validates them using the results.
+	resolve(ctx context.Context, values []interface{}) (Rule, error)
+}
+
+// ExistsBatchRule is a validation rule that checks if values exist according to a batch lookup function.
+type ExistsBatchRule struct {
+	lookup   BatchLookupFunc
+	results  map[interface{}]bool
+	resolved bool
+	err      Error
+}
+
+// ExistsBatch returns a validation rule that checks if a value references an existing entity like Exists does,
+// but is meant to be used with Each so that all the elements of an iterable are looked up with a single call
+// instead of one call per element:
+//
+//	rule := validation.Each(validation.ExistsBatch(func(ctx context.Context, values []interface{}) (map[interface{}]bool, error) {
+//	    return products.ExistingIDs(ctx, values)
+//	}))
+//	err := validation.ValidateWithContext(ctx, order.ProductIDs, rule)
+//
+// Each calls the function once with the distinct non-empty elements, with pointers dereferenced, and each element
+// is then reported as not existing unless the returned map holds true for it. The elements must be comparable, and an
+// InternalError is returned otherwise.
+// When the rule is not used with Each, the function is called with the value being validated only.
+// Please refer to Unique for how the context and the errors returned by the function are handled.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func ExistsBatch(lookup BatchLookupFunc) ExistsBatchRule {
+	return ExistsBatchRule{
+		lookup: lookup,
+		err:    ErrNotExist,
+	}
+}
+
+// Error sets the error message for the rule.
+func (r ExistsBatchRule) Error(message string) ExistsBatchRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r ExistsBatchRule) ErrorObject(err Error) ExistsBatchRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not using a background context.
+func (r ExistsBatchRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(context.Background(), value)
+}
+
+// ValidateWithContext checks if the given value is valid or not using the results of the batch lookup.
+// If no lookup has been performed by Each, the value is looked up with the given context.
+func (r ExistsBatchRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	if !r.resolved {
+		rule, err := r.resolve(ctx, []interface{}{value})
+		if err != nil {
+			return err
+		}
+		r = rule.(ExistsBatchRule)
+	}
+	if !r.results[value] {
+		return r.err
+	}
+	return nil
+}
+
+func (r ExistsBatchRule) resolve(ctx context.Context, values []interface{}) (Rule, error) {
+	seen := make(map[interface{}]bool, len(values))
+	distinct := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		value, isNil := Indirect(value)
+		if isNil || IsEmpty(value) {
+			continue
+		}
+		if !reflect.ValueOf(value).Comparable() {
+			return nil, NewInternalError(fmt.Errorf("cannot look up a value of the non-comparable type %T", value))
+		}
+		if seen[value] {
+			continue
+		}
+		seen[value] = true
+		distinct = append(distinct, value)
+	}
+
+	r.resolved = true
+	if len(distinct) == 0 {
+		return r, nil
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, NewInternalError(err)
+	}
+	results, err := r.lookup(ctx, distinct)
+	if err != nil {
+		return nil, lookupError(err)
+	}
+	r.results = results
+	return r, nil
+}
+
+// resolveBatchRules performs the lookups of the batch rules among the given rules for all the elements
+// of a map, slice or array, and returns the rules with the batch rules replaced by their resolved copies.
+// The rules are returned as is if there is no batch rule among them.
+func resolveBatchRules(ctx context.Context, v reflect.Value, rules []Rule) ([]Rule, error) {
+	rules = expandRuleSets(rules)
+	var values []interface{}
+	var resolved []Rule
+	for i, rule := range rules {
+		br, ok := rule.(batchRule)
+		if !ok {
+			continue
+		}
+		if values == nil {
+			values = iterableValues(v)
+			resolved = append([]Rule(nil), rules...)
+		}
+		rr, err := br.resolve(ctx, values)
+		if err != nil {
+			return nil, err
+		}
+		resolved[i] = rr
+	}
+	if resolved == nil {
+		return rules, nil
+	}
+	return resolved, nil
+}
+
+// iterableValues returns the elements of a map, slice or array.
+func iterableValues(v reflect.Value) []interface{} {
+	values := make([]interface{}, 0, v.Len())
+	if v.Kind() == reflect.Map {
+		iter := v.MapRange()
+		for iter.Next() {
+			values = append(values, iter.Value().Interface())
+		}
+		return values
+	}
+	for i := 0; i < v.Len(); i++ {
+		values = append(values, v.Index(i).Interface())
+	}
+	return values
+}
@@ -0,0 +1,92 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// productLookup is a batch lookup source for tests that records its calls. The value -1 simulates a failed lookup.
+type productLookup struct {
+	calls [][]interface{}
+}
+
+func (l *productLookup) exists(ctx context.Context, values []interface{}) (map[interface{}]bool, error) {
+	sorted := append([]interface{}(nil), values...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].(int) < sorted[j].(int) })
+	l.calls = append(l.calls, sorted)
+	results := map[interface{}]bool{}
+	for _, v := range values {
+		if v == -1 {
+			return nil, errors.New("timeout")
+		}
+		results[v] = v.(int)%2 == 1
+	}
+	return results, nil
+}
+
+func TestExistsBatch(t *testing.T) {
+	one, two := 1, 2
+	tests := []struct {
+		tag   string
+		rule  func(l *productLookup) Rule
+		value interface{}
+		err   string
+		calls [][]interface{}
+	}{
+		{"t1", func(l *productLookup) Rule { return Each(ExistsBatch(l.exists)) }, []int{1, 3, 1, 5}, "", [][]interface{}{{1, 3, 5}}},
+		{"t2", func(l *productLookup) Rule { return Each(ExistsBatch(l.exists)) }, []int{1, 2, 3, 4}, "1: referenced value does not exist; 3: referenced value does not exist.", [][]interface{}{{1, 2, 3, 4}}},
+		{"t3", func(l *productLookup) Rule { return Each(ExistsBatch(l.exists)) }, []*int{&one, nil, &two}, "2: referenced value does not exist.", [][]interface{}{{1, 2}}},
+		{"t4", func(l *productLookup) Rule { return Each(ExistsBatch(l.exists)) }, map[string]int{"a": 1, "b": 2, "c": 0}, "b: referenced value does not exist.", [][]interface{}{{1, 2}}},
+		{"t5", func(l *productLookup) Rule { return Each(ExistsBatch(l.exists)) }, []int{0, 0}, "", nil},
+		{"t6", func(l *productLookup) Rule { return Each(ExistsBatch(l.exists)) }, []int{}, "", nil},
+		{"t7", func(l *productLookup) Rule { return Each(Required, ExistsBatch(l.exists)) }, []int{1, 0}, "1: cannot be blank.", [][]interface{}{{1}}},
+		{"t8", func(l *productLookup) Rule { return Each(RuleSet(Min(1), ExistsBatch(l.exists))).Parallel(2) }, []int{1, 2, 3}, "1: referenced value does not exist.", [][]interface{}{{1, 2, 3}}},
+		{"t9", func(l *productLookup) Rule { return Each(ExistsBatch(l.exists)) }, []int{1, -1}, "timeout", [][]interface{}{{-1, 1}}},
+		{"t10", func(l *productLookup) Rule { return ExistsBatch(l.exists) }, 2, "referenced value does not exist", [][]interface{}{{2}}},
+		{"t11", func(l *productLookup) Rule { return ExistsBatch(l.exists) }, 0, "", nil},
+	}
+
+	for _, test := range tests {
+		l := &productLookup{}
+		err := ValidateWithContext(context.Background(), test.value, test.rule(l))
+		assertError(t, test.err, err, test.tag)
+		assert.Equal(t, test.calls, l.calls, test.tag)
+	}
+
+	l := &productLookup{}
+	_, ok := Validate([]int{1, -1}, Each(ExistsBatch(l.exists))).(InternalError)
+	assert.True(t, ok)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := ValidateWithContext(ctx, []int{1, 2}, Each(ExistsBatch(l.exists)))
+	assert.Equal(t, NewInternalError(context.Canceled), err)
+
+	// non-comparable elements cannot be looked up
+	l = &productLookup{}
+	err = Validate([][]int{{1}}, Each(ExistsBatch(l.exists)))
+	assert.EqualError(t, err, "cannot look up a value of the non-comparable type []int")
+	_, ok = err.(InternalError)
+	assert.True(t, ok)
+	err = Validate([]interface{}{struct{ V interface{} }{[]int{1}}}, Each(ExistsBatch(l.exists)))
+	_, ok = err.(InternalError)
+	assert.True(t, ok)
+	_, ok = ExistsBatch(l.exists).Validate(map[string]int{"a": 1}).(InternalError)
+	assert.True(t, ok)
+	assert.Empty(t, l.calls)
+}
+
+func TestExistsBatchRule_Error(t *testing.T) {
+	r := ExistsBatch(nil)
+	assert.Equal(t, "referenced value does not exist", r.err.Message())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+	assert.Equal(t, err, r.err)
+}
@@ -0,0 +1,90 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// ErrNotBusinessDay is the error that returns when a date is not a business day.
+var ErrNotBusinessDay = NewError("validation_not_business_day", "must be a business day")
+
+// BusinessDayRule is a validation rule that checks if a date is a business day.
+type BusinessDayRule struct {
+	holidays map[civilDate]struct{}
+	weekend  [7]bool
+	err      Error
+}
+
+// civilDate is the date portion of a time.Time.
+type civilDate struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+func toCivilDate(t time.Time) civilDate {
+	y, m, d := t.Date()
+	return civilDate{y, m, d}
+}
+
+// BusinessDay returns a validation rule that checks if a time.Time value is a business day, i.e. it is neither
+// on a weekend nor on one of the given holidays. By default, the weekend is Saturday and Sunday; call Weekend
+// to use a different definition, e.g. Weekend(time.Friday, time.Saturday).
+// Only the date portion is compared: the value and the holidays are compared by their year, month and day
+// in their own locations, ignoring the time of day.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func BusinessDay(holidays []time.Time) BusinessDayRule {
+	r := BusinessDayRule{
+		holidays: make(map[civilDate]struct{}, len(holidays)),
+		err:      ErrNotBusinessDay,
+	}
+	for _, h := range holidays {
+		r.holidays[toCivilDate(h)] = struct{}{}
+	}
+	r.weekend[time.Saturday] = true
+	r.weekend[time.Sunday] = true
+	return r
+}
+
+// Weekend sets the days of the week that are not business days. Calling it without days means
+// that every day of the week except the holidays is a business day.
+func (r BusinessDayRule) Weekend(days ...time.Weekday) BusinessDayRule {
+	r.weekend = [7]bool{}
+	for _, day := range days {
+		r.weekend[day] = true
+	}
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r BusinessDayRule) Error(message string) BusinessDayRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r BusinessDayRule) ErrorObject(err Error) BusinessDayRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r BusinessDayRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	t, ok := value.(time.Time)
+	if !ok {
+		return fmt.Errorf("cannot convert %v to time.Time", reflect.TypeOf(value))
+	}
+	if r.weekend[t.Weekday()] {
+		return r.err
+	}
+	if _, ok := r.holidays[toCivilDate(t)]; ok {
+		return r.err
+	}
+	return nil
+}
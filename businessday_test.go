@@ -0,0 +1,65 @@
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBusinessDay(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	holidays := []time.Time{
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 3, 15, 30, 0, 0, tokyo),
+	}
+	var tm *time.Time
+
+	tests := []struct {
+		tag   string
+		rule  BusinessDayRule
+		value interface{}
+		err   string
+	}{
+		// 2024-01-01 is a Monday
+		{"t1", BusinessDay(nil), time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC), ""},
+		{"t2", BusinessDay(nil), time.Date(2024, 1, 6, 10, 0, 0, 0, time.UTC), "must be a business day"},
+		{"t3", BusinessDay(nil), time.Date(2024, 1, 7, 10, 0, 0, 0, time.UTC), "must be a business day"},
+		{"t4", BusinessDay(nil), time.Date(2024, 1, 5, 10, 0, 0, 0, time.UTC), ""},
+		{"t5", BusinessDay(holidays), time.Date(2024, 1, 1, 23, 59, 0, 0, time.UTC), "must be a business day"},
+		{"t6", BusinessDay(holidays), time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), ""},
+		// holidays are compared on their date portion, in their own locations
+		{"t7", BusinessDay(holidays), time.Date(2024, 1, 3, 8, 0, 0, 0, time.UTC), "must be a business day"},
+		{"t8", BusinessDay(holidays), time.Date(2024, 1, 3, 9, 0, 0, 0, tokyo), "must be a business day"},
+		{"t9", BusinessDay(holidays), time.Date(2024, 1, 1, 20, 0, 0, 0, time.UTC).In(tokyo), ""},
+		{"t10", BusinessDay(nil).Weekend(time.Friday, time.Saturday), time.Date(2024, 1, 5, 10, 0, 0, 0, time.UTC), "must be a business day"},
+		{"t11", BusinessDay(nil).Weekend(time.Friday, time.Saturday), time.Date(2024, 1, 7, 10, 0, 0, 0, time.UTC), ""},
+		{"t12", BusinessDay(holidays).Weekend(), time.Date(2024, 1, 6, 10, 0, 0, 0, time.UTC), ""},
+		{"t13", BusinessDay(holidays).Weekend(), time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), "must be a business day"},
+		{"t14", BusinessDay(nil), time.Time{}, ""},
+		{"t15", BusinessDay(nil), tm, ""},
+		{"t16", BusinessDay(nil), "2024-01-06", "cannot convert string to time.Time"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func Test_BusinessDayRule_Error(t *testing.T) {
+	r := BusinessDay(nil)
+	assert.Equal(t, "must be a business day", r.err.Message())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestBusinessDayRule_ErrorObject(t *testing.T) {
+	r := BusinessDay(nil)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
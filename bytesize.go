@@ -0,0 +1,145 @@
+package validation
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrByteSizeInvalid is the error that returns in case of an invalid byte size.
+	ErrByteSizeInvalid = NewError("validation_byte_size_invalid", "must be a valid byte size")
+	// ErrByteSizeTooSmall is the error that returns when a byte size is less than the minimum.
+	ErrByteSizeTooSmall = NewError("validation_byte_size_too_small", "must be no less than {{.min}}")
+	// ErrByteSizeTooLarge is the error that returns when a byte size is greater than the maximum.
+	ErrByteSizeTooLarge = NewError("validation_byte_size_too_large", "must be no greater than {{.max}}")
+	// ErrByteSizeOutOfRange is the error that returns when a byte size is out of the specified range.
+	ErrByteSizeOutOfRange = NewError("validation_byte_size_out_of_range", "must be between {{.min}} and {{.max}}")
+
+	reByteSize = regexp.MustCompile(`^([0-9]+(?:\.[0-9]+)?)\s*([a-zA-Z]*)$`)
+
+	byteSizeUnits = map[string]float64{
+		"":    1,
+		"b":   1,
+		"kb":  1e3,
+		"mb":  1e6,
+		"gb":  1e9,
+		"tb":  1e12,
+		"kib": 1 << 10,
+		"mib": 1 << 20,
+		"gib": 1 << 30,
+		"tib": 1 << 40,
+	}
+)
+
+// ByteSizeRule is a validation rule that checks if a string is a human-readable byte size within the specified range.
+type ByteSizeRule struct {
+	min, max         string
+	minSize, maxSize uint64
+	boundsErr        error
+	err, rangeErr    Error
+}
+
+// ByteSize returns a validation rule that checks if a string is a human-readable byte size, such as "512MB" or "1.5GiB",
+// and that the size is within the specified range. The bounds are specified in the same format as the value.
+// An empty bound means there is no limit on that side.
+// Both decimal units (KB, MB, GB, TB) and binary units (KiB, MiB, GiB, TiB) are supported. Units are case-insensitive,
+// and a number without a unit is treated as a number of bytes.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func ByteSize(min, max string) ByteSizeRule {
+	r := ByteSizeRule{min: min, max: max, err: ErrByteSizeInvalid}
+	if min != "" && max != "" {
+		r.rangeErr = ErrByteSizeOutOfRange
+	} else if min != "" {
+		r.rangeErr = ErrByteSizeTooSmall
+	} else {
+		r.rangeErr = ErrByteSizeTooLarge
+	}
+	r.rangeErr = r.rangeErr.SetParams(map[string]interface{}{"min": min, "max": max})
+
+	if min != "" {
+		if r.minSize, r.boundsErr = ParseByteSize(min); r.boundsErr != nil {
+			return r
+		}
+	}
+	if max != "" {
+		r.maxSize, r.boundsErr = ParseByteSize(max)
+	}
+	return r
+}
+
+// ParseByteSize parses a human-readable byte size, such as "512MB" or "1.5GiB", into a number of bytes.
+// Please refer to ByteSize for the supported format.
+func ParseByteSize(s string) (uint64, error) {
+	m := reByteSize.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return 0, fmt.Errorf("invalid byte size: %q", s)
+	}
+	unit, ok := byteSizeUnits[strings.ToLower(m[2])]
+	if !ok {
+		return 0, fmt.Errorf("unknown byte size unit: %q", m[2])
+	}
+	n, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return 0, err
+	}
+	size := n * unit
+	if size >= math.MaxUint64 {
+		return 0, fmt.Errorf("byte size is too large: %q", s)
+	}
+	return uint64(math.Round(size)), nil
+}
+
+// Error sets the error message that is used when the value being validated is not a valid byte size.
+func (r ByteSizeRule) Error(message string) ByteSizeRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct that is used when the value being validated is not a valid byte size.
+func (r ByteSizeRule) ErrorObject(err Error) ByteSizeRule {
+	r.err = err
+	return r
+}
+
+// RangeError sets the error message that is used when the value being validated is out of the specified range.
+func (r ByteSizeRule) RangeError(message string) ByteSizeRule {
+	r.rangeErr = r.rangeErr.SetMessage(message)
+	return r
+}
+
+// RangeErrorObject sets the error struct that is used when the value being validated is out of the specified range.
+func (r ByteSizeRule) RangeErrorObject(err Error) ByteSizeRule {
+	r.rangeErr = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r ByteSizeRule) Validate(value interface{}) error {
+	if r.boundsErr != nil {
+		return NewInternalError(r.boundsErr)
+	}
+
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	size, err := ParseByteSize(str)
+	if err != nil {
+		return r.err
+	}
+
+	if r.min != "" && size < r.minSize || r.max != "" && size > r.maxSize {
+		return r.rangeErr
+	}
+
+	return nil
+}
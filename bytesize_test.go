@@ -0,0 +1,76 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseByteSize(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value string
+		size  uint64
+		err   string
+	}{
+		{"t1", "512", 512, ""},
+		{"t2", "512B", 512, ""},
+		{"t3", "512MB", 512000000, ""},
+		{"t4", "1.5GB", 1500000000, ""},
+		{"t5", "2 KiB", 2048, ""},
+		{"t6", "1mib", 1048576, ""},
+		{"t7", "1TB", 1000000000000, ""},
+		{"t8", "1TiB", 1099511627776, ""},
+		{"t9", "1.5", 2, ""},
+		{"t10", "abc", 0, `invalid byte size: "abc"`},
+		{"t11", "-1MB", 0, `invalid byte size: "-1MB"`},
+		{"t12", "1XB", 0, `unknown byte size unit: "XB"`},
+	}
+
+	for _, test := range tests {
+		size, err := ParseByteSize(test.value)
+		assert.Equal(t, test.size, size, test.tag)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestByteSize(t *testing.T) {
+	var s *string
+	tests := []struct {
+		tag      string
+		min, max string
+		value    interface{}
+		err      string
+	}{
+		{"t1", "512MB", "2GB", "", ""},
+		{"t2", "512MB", "2GB", s, ""},
+		{"t3", "512MB", "2GB", "1GB", ""},
+		{"t4", "512MB", "2GB", "512MB", ""},
+		{"t5", "512MB", "2GB", "2GB", ""},
+		{"t6", "512MB", "2GB", "1.5GiB", ""},
+		{"t7", "512MB", "2GB", "2GiB", "must be between 512MB and 2GB"},
+		{"t8", "512MB", "2GB", "100MB", "must be between 512MB and 2GB"},
+		{"t9", "512MB", "", "100MB", "must be no less than 512MB"},
+		{"t10", "", "2GB", "3GB", "must be no greater than 2GB"},
+		{"t11", "", "", "3PB", "must be a valid byte size"},
+		{"t12", "", "", []byte("3TB"), ""},
+		{"t13", "", "", 100, "must be either a string or byte slice"},
+		{"t14", "abc", "", "1GB", `invalid byte size: "abc"`},
+	}
+
+	for _, test := range tests {
+		err := ByteSize(test.min, test.max).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestByteSizeRule_Error(t *testing.T) {
+	r := ByteSize("1KB", "1MB").Error("bad").RangeError("{{.min}}..{{.max}}")
+	assert.EqualError(t, r.Validate("abc"), "bad")
+	assert.EqualError(t, r.Validate("1GB"), "1KB..1MB")
+
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err).RangeErrorObject(err)
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err, r.rangeErr)
+}
@@ -0,0 +1,87 @@
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+// ErrJSONNotCanonical is the error that returns when a JSON document is not in canonical form.
+var ErrJSONNotCanonical = NewError("validation_json_not_canonical", "JSON must be in canonical form")
+
+// CanonicalJSON is a validation rule that checks if a string or byte slice is a JSON document in canonical form,
+// i.e. it is identical to the document re-encoded by encoding/json: object keys are sorted, there is no
+// insignificant white space, and strings are escaped the way encoding/json escapes them (except for HTML
+// characters, which are kept). Numbers are kept as they are written. This helps keep stored data consistent.
+// If the value is not valid JSON, an error is returned as well.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+var CanonicalJSON = CanonicalJSONRule{}
+
+// CanonicalJSONRule is a validation rule that checks if a JSON document is in canonical form.
+type CanonicalJSONRule struct {
+	err Error
+}
+
+// Error sets the error message for the rule.
+func (r CanonicalJSONRule) Error(message string) CanonicalJSONRule {
+	r.err = r.defaultError().SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r CanonicalJSONRule) ErrorObject(err Error) CanonicalJSONRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r CanonicalJSONRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	canonical, err := canonicalizeJSON([]byte(str))
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(canonical, []byte(str)) {
+		return r.defaultError()
+	}
+	return nil
+}
+
+// canonicalizeJSON re-encodes a JSON document in canonical form.
+func canonicalizeJSON(data []byte) ([]byte, error) {
+	var doc interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&doc); err != nil {
+		return nil, errors.New("must be in valid JSON format")
+	}
+	if _, err := d.Token(); err != io.EOF {
+		return nil, errors.New("must be in valid JSON format")
+	}
+
+	var buf bytes.Buffer
+	e := json.NewEncoder(&buf)
+	e.SetEscapeHTML(false)
+	if err := e.Encode(doc); err != nil {
+		return nil, NewInternalError(err)
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// defaultError returns the error set for the rule, or ErrJSONNotCanonical.
+func (r CanonicalJSONRule) defaultError() Error {
+	if r.err != nil {
+		return r.err
+	}
+	return ErrJSONNotCanonical
+}
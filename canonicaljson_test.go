@@ -0,0 +1,54 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanonicalJSON(t *testing.T) {
+	var s *string
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", `{"a":1,"b":[true,null,"x"]}`, ""},
+		{"t2", `{"b":1,"a":2}`, "JSON must be in canonical form"},
+		{"t3", `{"a": 1}`, "JSON must be in canonical form"},
+		{"t4", `{"a":1} `, "JSON must be in canonical form"},
+		{"t5", `{"a":{"c":1,"b":2}}`, "JSON must be in canonical form"},
+		{"t6", `{"a":"<b>"}`, ""},
+		{"t7", `"\u00e9"`, "JSON must be in canonical form"},
+		{"t8", `"é"`, ""},
+		{"t9", `1.50`, ""},
+		{"t10", `{"a":1`, "must be in valid JSON format"},
+		{"t11", `{"a":1}{"b":2}`, "must be in valid JSON format"},
+		{"t12", []byte(`[1,2]`), ""},
+		{"t13", "", ""},
+		{"t14", s, ""},
+		{"t15", 1, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		err := CanonicalJSON.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func Test_CanonicalJSONRule_Error(t *testing.T) {
+	r := CanonicalJSON.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+	assert.Equal(t, "validation_json_not_canonical", r.err.Code())
+	assert.Nil(t, CanonicalJSON.err)
+}
+
+func TestCanonicalJSONRule_ErrorObject(t *testing.T) {
+	r := CanonicalJSON
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
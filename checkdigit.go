@@ -0,0 +1,162 @@
+package validation
+
+import (
+	"fmt"
+	"sync"
+)
+
+// CheckDigitFunc checks if the check digit of a value is correct according to a check digit scheme.
+type CheckDigitFunc func(value string) bool
+
+// The names of the built-in check digit schemes.
+const (
+	// CheckDigitISBN10 is the ISBN-10 scheme (modulo 11, the check digit may be "X").
+	CheckDigitISBN10 = "isbn10"
+	// CheckDigitISBN13 is the ISBN-13 scheme, which is EAN-13 with the 978 or 979 prefix.
+	CheckDigitISBN13 = "isbn13"
+	// CheckDigitEAN13 is the EAN-13 scheme (modulo 10 with weights 1 and 3).
+	CheckDigitEAN13 = "ean13"
+	// CheckDigitLuhn is the Luhn scheme used by credit card numbers and IMEIs.
+	CheckDigitLuhn = "luhn"
+)
+
+// ErrCheckDigitInvalid is the error that returns when the check digit of a value is not correct.
+var ErrCheckDigitInvalid = NewError("validation_check_digit_invalid", "invalid check digit")
+
+var (
+	checkDigitSchemes = map[string]CheckDigitFunc{
+		CheckDigitISBN10: isValidISBN10,
+		CheckDigitISBN13: isValidISBN13,
+		CheckDigitEAN13:  isValidEAN13,
+		CheckDigitLuhn:   isValidLuhn,
+	}
+	checkDigitSchemesMu sync.RWMutex
+)
+
+// RegisterCheckDigitScheme registers a check digit scheme so that it can be used with CheckDigit.
+// Registering an existing scheme replaces it.
+func RegisterCheckDigitScheme(name string, f CheckDigitFunc) {
+	checkDigitSchemesMu.Lock()
+	defer checkDigitSchemesMu.Unlock()
+	checkDigitSchemes[name] = f
+}
+
+// CheckDigitRule is a validation rule that checks the check digit of a string.
+type CheckDigitRule struct {
+	scheme string
+	err    Error
+}
+
+// CheckDigit returns a validation rule that checks if a string has a correct check digit according to the named
+// scheme. The built-in schemes are CheckDigitISBN10, CheckDigitISBN13, CheckDigitEAN13 and CheckDigitLuhn, and more
+// can be registered with RegisterCheckDigitScheme. The value must consist of the digits only, without separators.
+// If the scheme is not registered, an InternalError is returned when validating.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func CheckDigit(scheme string) CheckDigitRule {
+	return CheckDigitRule{
+		scheme: scheme,
+		err:    ErrCheckDigitInvalid.SetParams(map[string]interface{}{"scheme": scheme}),
+	}
+}
+
+// Error sets the error message for the rule.
+func (r CheckDigitRule) Error(message string) CheckDigitRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r CheckDigitRule) ErrorObject(err Error) CheckDigitRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r CheckDigitRule) Validate(value interface{}) error {
+	checkDigitSchemesMu.RLock()
+	f, ok := checkDigitSchemes[r.scheme]
+	checkDigitSchemesMu.RUnlock()
+	if !ok {
+		return NewInternalError(fmt.Errorf("unknown check digit scheme %q", r.scheme))
+	}
+
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	if f(str) {
+		return nil
+	}
+	return r.err
+}
+
+// isDigits checks if a string consists of ASCII digits only.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isValidISBN10(s string) bool {
+	if len(s) != 10 || !isDigits(s[:9]) {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += (10 - i) * int(s[i]-'0')
+	}
+	switch c := s[9]; {
+	case c == 'X' || c == 'x':
+		sum += 10
+	case c >= '0' && c <= '9':
+		sum += int(c - '0')
+	default:
+		return false
+	}
+	return sum%11 == 0
+}
+
+func isValidISBN13(s string) bool {
+	return len(s) == 13 && (s[:3] == "978" || s[:3] == "979") && isValidEAN13(s)
+}
+
+func isValidEAN13(s string) bool {
+	if len(s) != 13 || !isDigits(s) {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 13; i++ {
+		d := int(s[i] - '0')
+		if i%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+func isValidLuhn(s string) bool {
+	if len(s) < 2 || !isDigits(s) {
+		return false
+	}
+	sum := 0
+	for i := len(s) - 1; i >= 0; i-- {
+		d := int(s[i] - '0')
+		if (len(s)-i)%2 == 0 {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
@@ -0,0 +1,78 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckDigit(t *testing.T) {
+	var s *string
+	tests := []struct {
+		tag    string
+		scheme string
+		value  interface{}
+		err    string
+	}{
+		{"t1", CheckDigitISBN10, "0306406152", ""},
+		{"t2", CheckDigitISBN10, "0306406153", "invalid check digit"},
+		{"t3", CheckDigitISBN10, "080442957X", ""},
+		{"t4", CheckDigitISBN10, "080442957x", ""},
+		{"t5", CheckDigitISBN10, "0-306-40615-2", "invalid check digit"},
+		{"t6", CheckDigitISBN13, "9780306406157", ""},
+		{"t7", CheckDigitISBN13, "9780306406158", "invalid check digit"},
+		{"t8", CheckDigitISBN13, "4006381333931", "invalid check digit"},
+		{"t9", CheckDigitEAN13, "4006381333931", ""},
+		{"t10", CheckDigitEAN13, "4006381333932", "invalid check digit"},
+		{"t11", CheckDigitEAN13, "400638133393", "invalid check digit"},
+		{"t12", CheckDigitLuhn, "79927398713", ""},
+		{"t13", CheckDigitLuhn, "79927398710", "invalid check digit"},
+		{"t14", CheckDigitLuhn, "4111111111111111", ""},
+		{"t15", CheckDigitLuhn, "0", "invalid check digit"},
+		{"t16", CheckDigitLuhn, "7992739871a", "invalid check digit"},
+		{"t17", CheckDigitLuhn, "", ""},
+		{"t18", CheckDigitLuhn, s, ""},
+		{"t19", CheckDigitLuhn, []byte("79927398713"), ""},
+		{"t20", CheckDigitLuhn, 79927398713, "must be either a string or byte slice"},
+		{"t21", "unknown", "123", `unknown check digit scheme "unknown"`},
+	}
+
+	for _, test := range tests {
+		err := CheckDigit(test.scheme).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestRegisterCheckDigitScheme(t *testing.T) {
+	defer func() {
+		checkDigitSchemesMu.Lock()
+		delete(checkDigitSchemes, "mod7")
+		checkDigitSchemesMu.Unlock()
+	}()
+
+	RegisterCheckDigitScheme("mod7", func(value string) bool {
+		return len(value)%7 == 0
+	})
+	assert.Nil(t, CheckDigit("mod7").Validate("1234567"))
+	err := CheckDigit("mod7").Validate("123")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "validation_check_digit_invalid", err.(Error).Code())
+		assert.Equal(t, "mod7", err.(Error).Params()["scheme"])
+	}
+}
+
+func Test_CheckDigitRule_Error(t *testing.T) {
+	r := CheckDigit(CheckDigitLuhn).Error("123")
+	assert.Equal(t, "123", r.err.Message())
+	assert.Equal(t, "invalid check digit", CheckDigit(CheckDigitLuhn).err.Message())
+}
+
+func TestCheckDigitRule_ErrorObject(t *testing.T) {
+	r := CheckDigit(CheckDigitLuhn)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,30 @@
+package validation
+
+import (
+	"context"
+	"time"
+)
+
+type clockKey struct{}
+
+// WithClock returns a copy of the context carrying the given clock. Rules that compare values against
+// the current time, such as MinAge, call the clock instead of time.Now when validating with the returned
+// context. This makes the validation results deterministic in tests, e.g.
+//
+//	ctx = validation.WithClock(ctx, func() time.Time {
+//	    return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+//	})
+//	err := validation.ValidateWithContext(ctx, birthdate, validation.MinAge(18))
+func WithClock(ctx context.Context, clock func() time.Time) context.Context {
+	return context.WithValue(ctx, clockKey{}, clock)
+}
+
+// now returns the current time according to the clock carried by the context, or time.Now if there is none.
+func now(ctx context.Context) time.Time {
+	if ctx != nil {
+		if clock, _ := ctx.Value(clockKey{}).(func() time.Time); clock != nil {
+			return clock()
+		}
+	}
+	return time.Now()
+}
@@ -0,0 +1,19 @@
+package validation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithClock(t *testing.T) {
+	fixed := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := WithClock(context.Background(), func() time.Time { return fixed })
+	assert.Equal(t, fixed, now(ctx))
+
+	before := time.Now()
+	assert.False(t, now(context.Background()).Before(before))
+	assert.False(t, now(nil).Before(before)) //nolint:staticcheck
+}
@@ -0,0 +1,78 @@
+package validation
+
+import "strings"
+
+// ErrProhibitedContent is the error that returns when a string contains a prohibited term.
+var ErrProhibitedContent = NewError("validation_prohibited_content", "contains prohibited content")
+
+// NotContainsAnyRule is a validation rule that checks if a string contains none of the given terms.
+type NotContainsAnyRule struct {
+	terms           []string
+	caseInsensitive bool
+	err             Error
+}
+
+// NotContainsAny returns a validation rule that checks if a string does not contain any of the given terms
+// as a substring. This is useful for basic content moderation of user names and similar input.
+// Empty terms are ignored. The terms are matched case-sensitively unless CaseInsensitive is called.
+//
+// The matched term is not reported by default, as it may be offensive or help users evade the check.
+// If IncludeValueInErrors is enabled, it is available as the "term" parameter of the error.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func NotContainsAny(terms []string) NotContainsAnyRule {
+	return NotContainsAnyRule{
+		terms: terms,
+		err:   ErrProhibitedContent,
+	}
+}
+
+// CaseInsensitive makes the rule match the terms case-insensitively.
+func (r NotContainsAnyRule) CaseInsensitive() NotContainsAnyRule {
+	r.caseInsensitive = true
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r NotContainsAnyRule) Error(message string) NotContainsAnyRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r NotContainsAnyRule) ErrorObject(err Error) NotContainsAnyRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r NotContainsAnyRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	if r.caseInsensitive {
+		str = strings.ToLower(str)
+	}
+	for _, term := range r.terms {
+		if term == "" {
+			continue
+		}
+		t := term
+		if r.caseInsensitive {
+			t = strings.ToLower(t)
+		}
+		if strings.Contains(str, t) {
+			if includeValue.Load() {
+				return r.err.SetParams(map[string]interface{}{"term": term})
+			}
+			return r.err
+		}
+	}
+	return nil
+}
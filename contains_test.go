@@ -0,0 +1,65 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotContainsAny(t *testing.T) {
+	var s *string
+	tests := []struct {
+		tag   string
+		rule  NotContainsAnyRule
+		value interface{}
+		err   string
+	}{
+		{"t1", NotContainsAny([]string{"admin", "root"}), "john", ""},
+		{"t2", NotContainsAny([]string{"admin", "root"}), "the_admin", "contains prohibited content"},
+		{"t3", NotContainsAny([]string{"admin", "root"}), "The_Admin", ""},
+		{"t4", NotContainsAny([]string{"admin", "root"}).CaseInsensitive(), "The_Admin", "contains prohibited content"},
+		{"t5", NotContainsAny([]string{"ADMIN"}).CaseInsensitive(), "the_admin", "contains prohibited content"},
+		{"t6", NotContainsAny([]string{""}), "john", ""},
+		{"t7", NotContainsAny(nil), "john", ""},
+		{"t8", NotContainsAny([]string{"root"}), "", ""},
+		{"t9", NotContainsAny([]string{"root"}), s, ""},
+		{"t10", NotContainsAny([]string{"root"}), []byte("groot"), "contains prohibited content"},
+		{"t11", NotContainsAny([]string{"root"}), 123, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestNotContainsAny_Term(t *testing.T) {
+	r := NotContainsAny([]string{"admin", "Root"}).CaseInsensitive()
+	err := r.Validate("groot")
+	if assert.NotNil(t, err) {
+		assert.Nil(t, err.(Error).Params())
+	}
+
+	IncludeValueInErrors(true)
+	defer IncludeValueInErrors(false)
+	err = r.Validate("groot")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "Root", err.(Error).Params()["term"])
+	}
+}
+
+func Test_NotContainsAnyRule_Error(t *testing.T) {
+	r := NotContainsAny([]string{"root"}).Error("123")
+	assert.Equal(t, "123", r.err.Message())
+	assert.Equal(t, "contains prohibited content", NotContainsAny(nil).err.Message())
+}
+
+func TestNotContainsAnyRule_ErrorObject(t *testing.T) {
+	r := NotContainsAny(nil)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
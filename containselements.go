@@ -0,0 +1,118 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+)
+
+var (
+	// ErrContainsRequired is the error that returns when a slice does not contain all the required values.
+	ErrContainsRequired = NewError("validation_contains_required", "must contain the required value")
+	// ErrContainsAnyRequired is the error that returns when a slice contains none of the required values.
+	ErrContainsAnyRequired = NewError("validation_contains_any_required", "must contain at least one of the required values")
+)
+
+// ContainsRule is a validation rule that checks if a slice or array contains the given values.
+type ContainsRule[T any] struct {
+	values   []T
+	matchAny bool
+	eq       func(a, b interface{}) bool
+	err      Error
+}
+
+// Contains returns a validation rule that checks if a slice or array contains the given value, e.g.
+//
+//	err := validation.Validate(user.Roles, validation.Contains("user"))
+//
+// Values are compared in the same way as the In rule does, unless a function is set with EqualFunc.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Contains[T any](value T) ContainsRule[T] {
+	return ContainsAll(value)
+}
+
+// ContainsAll returns a validation rule that checks if a slice or array contains all the given values.
+// Please refer to Contains for how values are compared.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func ContainsAll[T any](values ...T) ContainsRule[T] {
+	return ContainsRule[T]{values: values, err: ErrContainsRequired}
+}
+
+// ContainsAny returns a validation rule that checks if a slice or array contains at least one of the given values.
+// Please refer to Contains for how values are compared.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func ContainsAny[T any](values ...T) ContainsRule[T] {
+	return ContainsRule[T]{values: values, matchAny: true, err: ErrContainsAnyRequired}
+}
+
+// EqualFunc sets the function used to determine if an element equals a required value, as InFunc does for In.
+// The function is called with an element of the slice as the first argument and a required value as the second one.
+// Elements are indirected before being compared, so a pointer is compared by the value it references.
+func (r ContainsRule[T]) EqualFunc(eq func(a, b interface{}) bool) ContainsRule[T] {
+	r.eq = eq
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r ContainsRule[T]) Error(message string) ContainsRule[T] {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r ContainsRule[T]) ErrorObject(err Error) ContainsRule[T] {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r ContainsRule[T]) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	elements, err := sliceElements(value)
+	if err != nil {
+		return err
+	}
+
+	for _, required := range r.values {
+		found := containsElement(elements, required, r.eq)
+		if found && r.matchAny {
+			return nil
+		}
+		if !found && !r.matchAny {
+			return r.err
+		}
+	}
+	if r.matchAny && len(r.values) > 0 {
+		return r.err
+	}
+	return nil
+}
+
+// sliceElements returns the indirected elements of a slice or array, skipping nil ones.
+func sliceElements(value interface{}) ([]interface{}, error) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return nil, errors.New("must be a slice or an array")
+	}
+	elements := make([]interface{}, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		if e, isNil := Indirect(v.Index(i).Interface()); !isNil {
+			elements = append(elements, e)
+		}
+	}
+	return elements, nil
+}
+
+// containsElement checks if one of the elements equals the given value, using the given function if it is not nil.
+// The function is called with an element as the first argument.
+func containsElement[T any](elements []interface{}, value T, eq func(a, b interface{}) bool) bool {
+	for _, e := range elements {
+		if eq != nil && eq(e, value) || eq == nil && equalValues(value, e) {
+			return true
+		}
+	}
+	return false
+}
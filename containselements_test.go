@@ -0,0 +1,69 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContains(t *testing.T) {
+	type level int
+	admin := "admin"
+	var roles []string
+	tests := []struct {
+		tag   string
+		rule  Rule
+		value interface{}
+		err   string
+	}{
+		{"t1", Contains("user"), []string{"admin", "user"}, ""},
+		{"t2", Contains("user"), []string{"admin"}, "must contain the required value"},
+		{"t3", Contains("user"), [2]string{"user", "x"}, ""},
+		{"t4", Contains("admin"), []*string{nil, &admin}, ""},
+		{"t5", Contains(1), []level{2, 1}, ""},
+		{"t6", Contains(3), []interface{}{1, "3"}, "must contain the required value"},
+		{"t7", ContainsAll("a", "b"), []string{"b", "c", "a"}, ""},
+		{"t8", ContainsAll("a", "b"), []string{"a", "c"}, "must contain the required value"},
+		{"t9", ContainsAll[string](), []string{"a"}, ""},
+		{"t10", ContainsAny("a", "b"), []string{"c", "b"}, ""},
+		{"t11", ContainsAny("a", "b"), []string{"c", "d"}, "must contain at least one of the required values"},
+		{"t12", ContainsAny[string](), []string{"a"}, ""},
+		{"t13", Contains("user"), []string{}, ""},
+		{"t14", Contains("user"), roles, ""},
+		{"t15", Contains("user"), "user", "must be a slice or an array"},
+	}
+
+	for _, test := range tests {
+		err := Validate(test.value, test.rule)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestContainsRule_EqualFunc(t *testing.T) {
+	fold := func(a, b interface{}) bool {
+		return strings.EqualFold(a.(string), b.(string))
+	}
+	assert.Nil(t, Contains("user").EqualFunc(fold).Validate([]string{"USER"}))
+	assert.NotNil(t, Contains("user").Validate([]string{"USER"}))
+	assert.Nil(t, ContainsAny("a", "b").EqualFunc(fold).Validate([]string{"B"}))
+	assert.NotNil(t, ContainsAll("a", "b").EqualFunc(fold).Validate([]string{"B"}))
+}
+
+func Test_ContainsRule_Error(t *testing.T) {
+	r := Contains("a")
+	assert.Equal(t, "must contain the required value", r.err.Message())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+	assert.Equal(t, "validation_contains_any_required", ContainsAny("a").err.Code())
+}
+
+func TestContainsRule_ErrorObject(t *testing.T) {
+	r := Contains("a")
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,95 @@
+package validation
+
+import (
+	"reflect"
+	"strings"
+)
+
+// TestingT is the subset of testing.TB used by AssertAllFieldsCovered.
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+}
+
+// AssertAllFieldsCovered is a test helper that reports an error through t if any exported field of a struct is not
+// specified in the given field rules. It catches fields that are added to a struct but silently go unvalidated.
+// The struct must be specified as a pointer to it, and the field rules are usually the same as those passed to
+// ValidateStruct. For example,
+//
+//	func TestRequestRules(t *testing.T) {
+//	    var r Request
+//	    validation.AssertAllFieldsCovered(t, &r, r.fieldRules()...)
+//	}
+//
+// Fields specified within Rules() groups are considered covered regardless of their When conditions.
+// An embedded struct is covered if it is specified itself or all its exported fields are covered.
+// Fields whose validation tag (see ValidationTag) is "-" are not required to be covered.
+// It returns whether all fields are covered.
+func AssertAllFieldsCovered(t TestingT, structPtr interface{}, fields ...*FieldRules) bool {
+	t.Helper()
+
+	value := reflect.ValueOf(structPtr)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		t.Errorf("%v", ErrStructPointer)
+		return false
+	}
+
+	covered := map[fieldKey]bool{}
+	collectCoveredFields(fields, covered)
+
+	missing := uncoveredFields(value.Elem(), covered, "")
+	if len(missing) > 0 {
+		t.Errorf("fields without validation rules: %v", strings.Join(missing, ", "))
+		return false
+	}
+	return true
+}
+
+// fieldKey identifies a struct field by its address (or offset) and type,
+// as the first field of a struct shares the address of the struct.
+type fieldKey struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+// collectCoveredFields collects the fields specified in the field rules, including those in groups.
+func collectCoveredFields(fields []*FieldRules, covered map[fieldKey]bool) {
+	for _, fr := range fields {
+		if fr.isGroup {
+			collectCoveredFields(fr.group, covered)
+			continue
+		}
+		if fv := reflect.ValueOf(fr.fieldPtr); fv.Kind() == reflect.Ptr && !fv.IsNil() {
+			covered[fieldKey{fv.Pointer(), fv.Elem().Type()}] = true
+		}
+	}
+}
+
+// uncoveredFields returns the names of the exported fields of a struct that are not covered.
+func uncoveredFields(sv reflect.Value, covered map[fieldKey]bool, prefix string) []string {
+	var missing []string
+	st := sv.Type()
+	for i := 0; i < st.NumField(); i++ {
+		sf := st.Field(i)
+		if sf.Tag.Get(ValidationTag) == "-" {
+			continue
+		}
+		fv := sv.Field(i)
+		if sf.IsExported() && covered[fieldKey{fv.UnsafeAddr(), sf.Type}] {
+			continue
+		}
+		if sf.Anonymous {
+			if sf.Type.Kind() == reflect.Ptr && !fv.IsNil() {
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct {
+				missing = append(missing, uncoveredFields(fv, covered, prefix+sf.Name+".")...)
+				continue
+			}
+		}
+		if sf.IsExported() {
+			missing = append(missing, prefix+sf.Name)
+		}
+	}
+	return missing
+}
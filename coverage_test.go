@@ -0,0 +1,67 @@
+package validation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockT struct {
+	errors []string
+}
+
+func (t *mockT) Helper() {}
+
+func (t *mockT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertAllFieldsCovered(t *testing.T) {
+	type Base struct {
+		ID      int
+		Created string
+	}
+	type inner struct {
+		Note string
+	}
+	type request struct {
+		Base
+		inner
+		Name     string
+		Email    string
+		Internal string `validate:"-"`
+		secret   string
+	}
+	var r request
+
+	tests := []struct {
+		tag    string
+		fields []*FieldRules
+		err    string
+	}{
+		{"t1", []*FieldRules{Field(&r.Base), Field(&r.Note), Field(&r.Name), Field(&r.Email)}, ""},
+		{"t2", []*FieldRules{Field(&r.ID), Field(&r.Created), Field(&r.Note), Field(&r.Name), Field(&r.Email)}, ""},
+		{"t3", []*FieldRules{Field(&r.ID), Field(&r.Note), Field(&r.Name)}, "fields without validation rules: Base.Created, Email"},
+		{"t4", []*FieldRules{Field(&r.Base), Field(&r.Note), Rules(Field(&r.Name), Field(&r.Email)).When(false)}, ""},
+		{"t5", nil, "fields without validation rules: Base.ID, Base.Created, inner.Note, Name, Email"},
+	}
+
+	for _, test := range tests {
+		mt := &mockT{}
+		ok := AssertAllFieldsCovered(mt, &r, test.fields...)
+		if test.err == "" {
+			assert.True(t, ok, test.tag)
+			assert.Empty(t, mt.errors, test.tag)
+		} else {
+			assert.False(t, ok, test.tag)
+			assert.Equal(t, []string{test.err}, mt.errors, test.tag)
+		}
+	}
+
+	mt := &mockT{}
+	assert.False(t, AssertAllFieldsCovered(mt, r))
+	assert.Equal(t, []string{"only a pointer to a struct can be validated"}, mt.errors)
+
+	assert.True(t, AssertAllFieldsCovered(t, &r.Base, Field(&r.Base.ID), Field(&r.Base.Created)))
+}
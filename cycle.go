@@ -0,0 +1,56 @@
+package validation
+
+import (
+	"context"
+	"reflect"
+)
+
+type visitingKey struct{}
+
+// visitingPtr is a pointer being validated, linked to the pointers being validated by the enclosing validations.
+// The type is needed because a struct and its first field share the same address.
+type visitingPtr struct {
+	ptr    uintptr
+	typ    reflect.Type
+	parent *visitingPtr
+}
+
+// contains reports whether the given pointer is in the chain.
+func (p *visitingPtr) contains(ptr uintptr, typ reflect.Type) bool {
+	for ; p != nil; p = p.parent {
+		if p.ptr == ptr && p.typ == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// recordPointer returns a copy of the context recording that the given pointer is being validated.
+// The context is returned unchanged if the pointer is already recorded, or if it is nil.
+func recordPointer(ctx context.Context, value interface{}) context.Context {
+	rv := reflect.ValueOf(value)
+	if ctx == nil || rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return ctx
+	}
+	parent, _ := ctx.Value(visitingKey{}).(*visitingPtr)
+	ptr, typ := rv.Pointer(), rv.Type()
+	if parent.contains(ptr, typ) {
+		return ctx
+	}
+	return context.WithValue(ctx, visitingKey{}, &visitingPtr{ptr: ptr, typ: typ, parent: parent})
+}
+
+// enterPointer returns a copy of the context recording that the given pointer is being validated.
+// It returns false, together with the unchanged context, if the pointer is already being validated by an
+// enclosing validation, which means the value references back to one of its ancestors in a cyclic graph.
+// Values that are not non-nil pointers and nil contexts are never considered as being validated.
+func enterPointer(ctx context.Context, value interface{}) (context.Context, bool) {
+	rv := reflect.ValueOf(value)
+	if ctx == nil || rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return ctx, true
+	}
+	if p, _ := ctx.Value(visitingKey{}).(*visitingPtr); p.contains(rv.Pointer(), rv.Type()) {
+		return ctx, false
+	}
+	return recordPointer(ctx, value), true
+}
@@ -0,0 +1,91 @@
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type cycleNode struct {
+	Name string
+	Next *cycleNode
+}
+
+func (n *cycleNode) ValidateWithContext(ctx context.Context) error {
+	return ValidateStructWithContext(ctx, n,
+		Field(&n.Name, Required),
+		Field(&n.Next),
+	)
+}
+
+type plainCycleNode struct {
+	Name   string
+	Parent *parentCycleNode
+}
+
+func (n *plainCycleNode) Validate() error {
+	return ValidateStruct(n,
+		Field(&n.Name, Required),
+		Field(&n.Parent),
+	)
+}
+
+type parentCycleNode struct {
+	Name  string
+	Child *plainCycleNode
+}
+
+func (n *parentCycleNode) ValidateWithContext(ctx context.Context) error {
+	return ValidateStructWithContext(ctx, n,
+		Field(&n.Name, Required),
+		Field(&n.Child),
+	)
+}
+
+type taggedCycleNode struct {
+	Name string `validate:"required"`
+	Next *taggedCycleNode
+}
+
+func TestValidateStruct_Cycle(t *testing.T) {
+	a := &cycleNode{}
+	b := &cycleNode{Name: "b"}
+	c := &cycleNode{}
+	a.Next, b.Next, c.Next = b, c, a
+
+	err := ValidateWithContext(context.Background(), a)
+	assert.EqualError(t, err, "Name: cannot be blank; Next: (Next: (Name: cannot be blank.).).")
+
+	self := &cycleNode{}
+	self.Next = self
+	err = ValidateWithContext(context.Background(), self)
+	assert.EqualError(t, err, "Name: cannot be blank.")
+
+	// a node shared by several fields without forming a cycle is validated for each of them
+	shared := &cycleNode{}
+	err = ValidateWithContext(context.Background(), map[string]*cycleNode{"a": {Name: "a", Next: shared}, "b": {Name: "b", Next: shared}})
+	assert.EqualError(t, err, "a: (Next: (Name: cannot be blank.).); b: (Next: (Name: cannot be blank.).).")
+}
+
+func TestValidateStruct_PlainValidatableCycle(t *testing.T) {
+	// the context cannot be passed through Validate(), so a cycle is only detected if it contains
+	// a value implementing ValidatableWithContext
+	parent := &parentCycleNode{}
+	child := &plainCycleNode{Parent: parent}
+	parent.Child = child
+
+	// the parent recorded before calling child.Validate() is forgotten, so it is validated once more
+	err := ValidateWithContext(context.Background(), parent)
+	assert.EqualError(t, err, "Child: (Name: cannot be blank; Parent: (Name: cannot be blank.).); Name: cannot be blank.")
+	assert.EqualError(t, child.Validate(), "Name: cannot be blank; Parent: (Name: cannot be blank.).")
+}
+
+func TestValidateTagged_Cycle(t *testing.T) {
+	a := &taggedCycleNode{Name: "a"}
+	b := &taggedCycleNode{Next: a}
+	a.Next = b
+
+	err := ValidateTagged(a)
+	assert.EqualError(t, err, "Next: (Name: cannot be blank.).")
+}
@@ -5,6 +5,7 @@
 package validation
 
 import (
+	"context"
 	"time"
 )
 
@@ -19,6 +20,8 @@ var (
 type DateRule struct {
 	layout        string
 	min, max      time.Time
+	minNow        bool
+	maxNow        bool
 	err, rangeErr Error
 }
 
@@ -31,7 +34,8 @@ type DateRule struct {
 //	validation.Date("2006-01-02")
 //
 // By calling Min() and/or Max(), you can let the Date rule to check if a parsed date value is within
-// the specified date range.
+// the specified date range. MinNow() and MaxNow() use the current time as the range boundary instead,
+// which is taken from the clock set by WithClock when validating with ValidateWithContext.
 //
 // An empty value is considered valid. Use the Required rule to make sure a value is not empty.
 func Date(layout string) DateRule {
@@ -68,18 +72,35 @@ func (r DateRule) RangeErrorObject(err Error) DateRule {
 
 // Min sets the minimum date range. A zero value means skipping the minimum range validation.
 func (r DateRule) Min(min time.Time) DateRule {
-	r.min = min
+	r.min, r.minNow = min, false
 	return r
 }
 
 // Max sets the maximum date range. A zero value means skipping the maximum range validation.
 func (r DateRule) Max(max time.Time) DateRule {
-	r.max = max
+	r.max, r.maxNow = max, false
+	return r
+}
+
+// MinNow sets the minimum date range to the current time, i.e. the date must not be in the past.
+func (r DateRule) MinNow() DateRule {
+	r.min, r.minNow = time.Time{}, true
+	return r
+}
+
+// MaxNow sets the maximum date range to the current time, i.e. the date must not be in the future.
+func (r DateRule) MaxNow() DateRule {
+	r.max, r.maxNow = time.Time{}, true
 	return r
 }
 
 // Validate checks if the given value is a valid date.
 func (r DateRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(context.Background(), value)
+}
+
+// ValidateWithContext checks if the given value is a valid date using the clock carried by the context.
+func (r DateRule) ValidateWithContext(ctx context.Context, value interface{}) error {
 	value, isNil := Indirect(value)
 	if isNil || IsEmpty(value) {
 		return nil
@@ -95,7 +116,17 @@ func (r DateRule) Validate(value interface{}) error {
 		return r.err
 	}
 
-	if !r.min.IsZero() && r.min.After(date) || !r.max.IsZero() && date.After(r.max) {
+	min, max := r.min, r.max
+	if r.minNow || r.maxNow {
+		t := now(ctx)
+		if r.minNow {
+			min = t
+		}
+		if r.maxNow {
+			max = t
+		}
+	}
+	if !min.IsZero() && min.After(date) || !max.IsZero() && date.After(max) {
 		return r.rangeErr
 	}
 
@@ -5,6 +5,7 @@
 package validation
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -89,3 +90,22 @@ func TestDateRule_MinMax(t *testing.T) {
 		assert.Equal(t, "the date is out of range", err.Error())
 	}
 }
+
+func TestDateRule_MinMaxNow(t *testing.T) {
+	current := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	ctx := WithClock(context.Background(), func() time.Time { return current })
+
+	r := Date("2006-01-02 15:04").MaxNow()
+	assert.Nil(t, ValidateWithContext(ctx, "2024-06-15 12:00", r))
+	assert.Nil(t, ValidateWithContext(ctx, "2024-06-15 11:59", r))
+	assert.NotNil(t, ValidateWithContext(ctx, "2024-06-15 12:01", r))
+
+	r = Date("2006-01-02 15:04").MinNow()
+	assert.Nil(t, ValidateWithContext(ctx, "2024-06-15 12:01", r))
+	assert.NotNil(t, ValidateWithContext(ctx, "2024-06-15 11:59", r))
+	assert.NotNil(t, r.Validate("2000-01-01 00:00"))
+
+	// Min and Max replace the current time boundaries
+	r = r.Min(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.Nil(t, ValidateWithContext(ctx, "2010-01-01 00:00", r))
+}
@@ -0,0 +1,98 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// ErrDateFormatMismatch is the error that returns when a date does not match the format declared by another field.
+var ErrDateFormatMismatch = NewError("validation_date_format_mismatch", "date does not match the declared format")
+
+// DateFormatFromRule is a struct-level validation rule that checks if a date field matches the format
+// declared by another field.
+type DateFormatFromRule struct {
+	layoutPtr, datePtr interface{}
+	err                Error
+}
+
+// DateFormatFrom returns a struct-level validation rule that checks if the string date field can be parsed
+// with the layout held by the string layout field, using the same layout format as time.Parse. This supports
+// user-configurable date formats, e.g. in import tools. The fields must be specified as pointers to the fields
+// of the struct being validated, and the struct must be specified as a pointer to it. For example,
+//
+//	err := validation.Validate(&s, validation.DateFormatFrom(&s.DateFormat, &s.DateValue))
+//	fmt.Println(err)
+//	// DateValue: date does not match the declared format.
+//
+// The error is indexed by the error name of the date field, determined as in ValidateStruct, and the layout
+// is available as the "layout" parameter of the error. An empty date and a nil struct pointer are considered valid.
+func DateFormatFrom(layoutPtr, datePtr interface{}) DateFormatFromRule {
+	return DateFormatFromRule{
+		layoutPtr: layoutPtr,
+		datePtr:   datePtr,
+		err:       ErrDateFormatMismatch,
+	}
+}
+
+// Error sets the error message for the rule.
+func (r DateFormatFromRule) Error(message string) DateFormatFromRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r DateFormatFromRule) ErrorObject(err Error) DateFormatFromRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r DateFormatFromRule) Validate(value interface{}) error {
+	sv := reflect.ValueOf(value)
+	if sv.Kind() != reflect.Ptr || !sv.IsNil() && sv.Elem().Kind() != reflect.Struct {
+		return NewInternalError(ErrStructPointer)
+	}
+	if sv.IsNil() {
+		return nil
+	}
+	sv = sv.Elem()
+
+	layout, _, err := stringField(sv, r.layoutPtr, 0)
+	if err != nil {
+		return err
+	}
+	date, name, err := stringField(sv, r.datePtr, 1)
+	if err != nil {
+		return err
+	}
+	if date == "" {
+		return nil
+	}
+	if _, err := time.Parse(layout, date); err != nil {
+		return Errors{name: r.err.SetParams(map[string]interface{}{"layout": layout})}
+	}
+	return nil
+}
+
+// stringField returns the value and the error name of the string field of a struct specified as a pointer.
+// A nil *string field has an empty value.
+func stringField(sv reflect.Value, fieldPtr interface{}, index int) (string, string, error) {
+	fv := reflect.ValueOf(fieldPtr)
+	if fv.Kind() != reflect.Ptr {
+		return "", "", NewInternalError(ErrFieldPointer(index))
+	}
+	ft, name := lookupStructField(sv, fv)
+	if ft == nil {
+		return "", "", NewInternalError(ErrFieldNotFound(index))
+	}
+	value, isNil := Indirect(fv.Elem().Interface())
+	if isNil {
+		return "", name, nil
+	}
+	s, ok := value.(string)
+	if !ok {
+		return "", "", NewInternalError(fmt.Errorf("field %v must be a string", name))
+	}
+	return s, name, nil
+}
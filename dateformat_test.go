@@ -0,0 +1,71 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDateFormatFrom(t *testing.T) {
+	type record struct {
+		DateFormat string  `json:"date_format"`
+		DateValue  string  `json:"date"`
+		Other      *string `json:"other"`
+		Count      int
+	}
+	layout := "2006-01-02"
+	r := record{DateFormat: "02/01/2006", DateValue: "31/12/2024"}
+	r2 := record{DateFormat: "2006-01-02", DateValue: "31/12/2024"}
+	var nilRecord *record
+	other := "x"
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		rule  DateFormatFromRule
+		err   string
+	}{
+		{"t1", &r, DateFormatFrom(&r.DateFormat, &r.DateValue), ""},
+		{"t2", &r2, DateFormatFrom(&r2.DateFormat, &r2.DateValue), "date: date does not match the declared format."},
+		{"t3", nilRecord, DateFormatFrom(nil, nil), ""},
+		{"t4", r, DateFormatFrom(&r.DateFormat, &r.DateValue), "only a pointer to a struct can be validated"},
+		{"t5", &r, DateFormatFrom(r.DateFormat, &r.DateValue), "field #0 must be specified as a pointer"},
+		{"t6", &r, DateFormatFrom(&r.DateFormat, &other), "field #1 cannot be found in the struct"},
+		{"t7", &r, DateFormatFrom(&r.Count, &r.DateValue), "field Count must be a string"},
+		{"t8", &r, DateFormatFrom(&r.Other, &r.DateValue), "date: date does not match the declared format."},
+		{"t9", &r, DateFormatFrom(&r.DateFormat, &r.Other), ""},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	r.DateFormat = layout
+	err := Validate(&r, DateFormatFrom(&r.DateFormat, &r.DateValue))
+	assertError(t, "date: date does not match the declared format.", err, "t10")
+	if es, ok := err.(Errors); assert.True(t, ok, "t10") {
+		assert.Equal(t, "validation_date_format_mismatch", es["date"].(Error).Code())
+		assert.Equal(t, layout, es["date"].(Error).Params()["layout"])
+	}
+
+	r.DateValue = ""
+	assert.Nil(t, Validate(&r, DateFormatFrom(&r.DateFormat, &r.DateValue)), "t11")
+}
+
+func Test_DateFormatFromRule_Error(t *testing.T) {
+	s := struct{ Format, Date string }{Format: "2006", Date: "abc"}
+	r := DateFormatFrom(&s.Format, &s.Date).Error("must use the format {{.layout}}")
+	assertError(t, "Date: must use the format 2006.", r.Validate(&s), "t1")
+	assert.Equal(t, "date does not match the declared format", DateFormatFrom(nil, nil).err.Message())
+}
+
+func TestDateFormatFromRule_ErrorObject(t *testing.T) {
+	r := DateFormatFrom(nil, nil)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
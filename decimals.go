@@ -0,0 +1,77 @@
+package validation
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// ErrTooManyDecimals is the error that returns when a number has more decimal places than allowed.
+var ErrTooManyDecimals = NewError("validation_too_many_decimals", "must have at most {{.decimals}} decimal places")
+
+// MaxDecimalsRule is a validation rule that checks the number of decimal places of a float.
+type MaxDecimalsRule struct {
+	decimals int
+	err      Error
+}
+
+// MaxDecimals returns a validation rule that checks if a float has at most the given number of decimal places,
+// e.g. MaxDecimals(2) for an amount of money. Since most decimal fractions cannot be represented exactly as floats,
+// the value is scaled by 10^n and compared with its rounded value within a tolerance relative to the precision
+// of its type, so that values such as 0.1+0.2 are accepted while 0.125 is rejected for 2 places.
+// Integers are always valid, while NaN and infinities are reported as ErrNotFinite.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func MaxDecimals(n int) MaxDecimalsRule {
+	return MaxDecimalsRule{
+		decimals: n,
+		err:      ErrTooManyDecimals.SetParams(map[string]interface{}{"decimals": n}),
+	}
+}
+
+// Error sets the error message for the rule.
+func (r MaxDecimalsRule) Error(message string) MaxDecimalsRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r MaxDecimalsRule) ErrorObject(err Error) MaxDecimalsRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r MaxDecimalsRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	rv := reflect.ValueOf(value)
+	var epsilon float64
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return nil
+	case reflect.Float32:
+		epsilon = 1e-6
+	case reflect.Float64:
+		epsilon = 1e-12
+	default:
+		return fmt.Errorf("type not supported: %v", rv.Type())
+	}
+
+	f := rv.Float()
+	if !isFinite(f) {
+		return ErrNotFinite
+	}
+	scaled := f * math.Pow10(r.decimals)
+	if math.IsInf(scaled, 0) {
+		// a float too large to be scaled has no decimal places
+		return nil
+	}
+	if math.Abs(scaled-math.Round(scaled)) > epsilon*math.Max(math.Abs(scaled), 1) {
+		return r.err
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package validation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxDecimals(t *testing.T) {
+	var f *float64
+	tests := []struct {
+		tag   string
+		n     int
+		value interface{}
+		err   string
+	}{
+		{"t1", 2, 1.5, ""},
+		{"t2", 2, 1.25, ""},
+		{"t3", 2, 1.255, "must have at most 2 decimal places"},
+		{"t4", 2, 0.1 + 0.2, ""},
+		{"t5", 2, 19.99, ""},
+		{"t6", 2, -19.99, ""},
+		{"t7", 2, 1000000.01, ""},
+		{"t8", 2, 1000000.001, "must have at most 2 decimal places"},
+		{"t9", 2, float32(0.1), ""},
+		{"t10", 2, float32(0.125), "must have at most 2 decimal places"},
+		{"t11", 0, 3.0, ""},
+		{"t12", 0, 3.5, "must have at most 0 decimal places"},
+		{"t13", 2, math.NaN(), "must be a finite number"},
+		{"t14", 2, 12, ""},
+		{"t15", 2, 0.0, ""},
+		{"t16", 2, f, ""},
+		{"t17", 2, "1.5", "type not supported: string"},
+		{"t18", 2, math.Inf(1), "must be a finite number"},
+		{"t19", 2, math.Inf(-1), "must be a finite number"},
+		{"t20", 2, float32(math.Inf(1)), "must be a finite number"},
+		{"t21", 2, math.MaxFloat64, ""},
+	}
+
+	for _, test := range tests {
+		err := MaxDecimals(test.n).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func Test_MaxDecimalsRule_Error(t *testing.T) {
+	r := MaxDecimals(2)
+	assert.Equal(t, "must have at most {{.decimals}} decimal places", r.err.Message())
+	assert.Equal(t, map[string]interface{}{"decimals": 2}, r.err.Params())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestMaxDecimalsRule_ErrorObject(t *testing.T) {
+	r := MaxDecimals(2)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
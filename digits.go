@@ -0,0 +1,67 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// ErrDigitsInvalid is the error that returns when an integer does not have the expected number of digits.
+var ErrDigitsInvalid = NewError("validation_digits_invalid", "must be exactly {{.digits}} digits")
+
+// DigitsRule is a validation rule that checks the number of decimal digits of an integer.
+type DigitsRule struct {
+	digits int
+	err    Error
+}
+
+// Digits returns a validation rule that checks if the decimal representation of an integer has exactly
+// the given number of digits, e.g. Digits(5) for a 5-digit code. The sign of a negative number is not counted.
+// Note that an integer cannot have leading zeros, so a code such as "01234" should be validated as a string instead.
+// This rule should only be used for validating integer types.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Digits(n int) DigitsRule {
+	return DigitsRule{
+		digits: n,
+		err:    ErrDigitsInvalid.SetParams(map[string]interface{}{"digits": n}),
+	}
+}
+
+// Error sets the error message for the rule.
+func (r DigitsRule) Error(message string) DigitsRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r DigitsRule) ErrorObject(err Error) DigitsRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r DigitsRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	var s string
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		s = strconv.FormatInt(rv.Int(), 10)
+		if s[0] == '-' {
+			s = s[1:]
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		s = strconv.FormatUint(rv.Uint(), 10)
+	default:
+		return fmt.Errorf("type not supported: %v", rv.Type())
+	}
+
+	if len(s) != r.digits {
+		return r.err
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package validation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDigits(t *testing.T) {
+	var ip *int
+	code := 12345
+	tests := []struct {
+		tag   string
+		n     int
+		value interface{}
+		err   string
+	}{
+		{"t1", 5, 12345, ""},
+		{"t2", 5, 1234, "must be exactly 5 digits"},
+		{"t3", 5, 123456, "must be exactly 5 digits"},
+		{"t4", 5, -12345, ""},
+		{"t5", 5, uint16(54321), ""},
+		{"t6", 1, int8(-9), ""},
+		{"t7", 19, int64(math.MinInt64), ""},
+		{"t8", 20, uint64(math.MaxUint64), ""},
+		{"t9", 5, 0, ""},
+		{"t10", 5, ip, ""},
+		{"t11", 5, &code, ""},
+		{"t12", 5, "12345", "type not supported: string"},
+		{"t13", 5, 12345.0, "type not supported: float64"},
+	}
+
+	for _, test := range tests {
+		err := Digits(test.n).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func Test_DigitsRule_Error(t *testing.T) {
+	r := Digits(3).Error("need {{.digits}}")
+	assert.Equal(t, "need 3", r.Validate(1).Error())
+}
+
+func TestDigitsRule_ErrorObject(t *testing.T) {
+	r := Digits(3)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
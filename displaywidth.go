@@ -0,0 +1,95 @@
+package validation
+
+import (
+	"unicode"
+
+	"golang.org/x/text/width"
+)
+
+var (
+	// ErrDisplayWidthTooLong is the error that returns when the display width of a string is greater than the maximum.
+	ErrDisplayWidthTooLong = NewError("validation_display_width_too_long", "display width must be no more than {{.max}}")
+	// ErrDisplayWidthTooShort is the error that returns when the display width of a string is less than the minimum.
+	ErrDisplayWidthTooShort = NewError("validation_display_width_too_short", "display width must be no less than {{.min}}")
+	// ErrDisplayWidthOutOfRange is the error that returns when the display width of a string is out of the specified range.
+	ErrDisplayWidthOutOfRange = NewError("validation_display_width_out_of_range", "display width must be between {{.min}} and {{.max}}")
+)
+
+// DisplayWidthRule is a validation rule that checks if the display width of a string is within the specified range.
+type DisplayWidthRule struct {
+	min, max int
+	err      Error
+}
+
+// DisplayWidth returns a validation rule that checks if the number of columns a string occupies in a fixed-width
+// layout, such as a terminal or a label printer, is within the specified range. Wide and fullwidth East Asian
+// characters, as determined by golang.org/x/text/width, count as 2 columns; combining marks, format characters
+// (such as zero-width spaces) and control characters count as 0; all other characters count as 1.
+// If max is 0, it means there is no upper bound for the width.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func DisplayWidth(min, max int) DisplayWidthRule {
+	var err Error
+	switch {
+	case min > 0 && max > 0:
+		err = ErrDisplayWidthOutOfRange
+	case max > 0:
+		err = ErrDisplayWidthTooLong
+	default:
+		err = ErrDisplayWidthTooShort
+	}
+	return DisplayWidthRule{
+		min: min,
+		max: max,
+		err: err.SetParams(map[string]interface{}{"min": min, "max": max}),
+	}
+}
+
+// Error sets the error message for the rule.
+func (r DisplayWidthRule) Error(message string) DisplayWidthRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r DisplayWidthRule) ErrorObject(err Error) DisplayWidthRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r DisplayWidthRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	if w := displayWidth(str); w < r.min || r.max > 0 && w > r.max {
+		return r.err
+	}
+	return nil
+}
+
+// displayWidth returns the number of columns the given string occupies in a fixed-width layout.
+func displayWidth(s string) int {
+	w := 0
+	for _, c := range s {
+		switch {
+		case unicode.In(c, unicode.Mn, unicode.Me, unicode.Cf, unicode.Cc):
+		case isWideRune(c):
+			w += 2
+		default:
+			w++
+		}
+	}
+	return w
+}
+
+func isWideRune(c rune) bool {
+	kind := width.LookupRune(c).Kind()
+	return kind == width.EastAsianWide || kind == width.EastAsianFullwidth
+}
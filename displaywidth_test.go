@@ -0,0 +1,57 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisplayWidth(t *testing.T) {
+	var s *string
+	tests := []struct {
+		tag      string
+		min, max int
+		value    interface{}
+		err      string
+	}{
+		{"t1", 2, 4, "abcd", ""},
+		{"t2", 2, 4, "abcde", "display width must be between 2 and 4"},
+		{"t3", 2, 4, "日本", ""},
+		{"t4", 2, 4, "日本語", "display width must be between 2 and 4"},
+		{"t5", 2, 4, "ｱｲｳ", ""},
+		{"t6", 2, 4, "ＡＢ", ""},
+		{"t7", 2, 4, "ＡＢＣ", "display width must be between 2 and 4"},
+		{"t8", 2, 4, "e\u0301e\u0301", ""},
+		{"t9", 2, 4, "a\u200bb", ""},
+		{"t10", 2, 4, "한", ""},
+		{"t11", 0, 3, "日本", "display width must be no more than 3"},
+		{"t12", 3, 0, "日", "display width must be no less than 3"},
+		{"t13", 3, 0, "日本", ""},
+		{"t14", 2, 4, "", ""},
+		{"t15", 2, 4, s, ""},
+		{"t16", 2, 4, []byte("日本"), ""},
+		{"t17", 2, 4, 123, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		err := DisplayWidth(test.min, test.max).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func Test_DisplayWidthRule_Error(t *testing.T) {
+	r := DisplayWidth(1, 10)
+	assert.Equal(t, "display width must be between {{.min}} and {{.max}}", r.err.Message())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestDisplayWidthRule_ErrorObject(t *testing.T) {
+	r := DisplayWidth(1, 10)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
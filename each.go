@@ -8,12 +8,16 @@ import (
 	"context"
 	"errors"
 	"reflect"
+	"runtime"
 	"strconv"
+	"sync"
 )
 
 // Each returns a validation rule that loops through an iterable (map, slice or array)
 // and validates each value inside with the provided rules.
 // An empty iterable is considered valid. Use the Required rule to make sure the iterable is not empty.
+// Since the rules of a field stop at the first failing one, Field(&s.Items, Required, Each(...)) reports
+// an empty iterable as blank without validating the elements, and the element errors otherwise.
 func Each(rules ...Rule) EachRule {
 	return EachRule{
 		rules: rules,
@@ -22,7 +26,61 @@ func Each(rules ...Rule) EachRule {
 
 // EachRule is a validation rule that validates elements in a map/slice/array using the specified list of rules.
 type EachRule struct {
-	rules []Rule
+	rules    []Rule
+	keyRules []Rule
+	workers  int
+}
+
+// KeyError represents a validation error of a map key as opposed to that of the value associated with the key.
+// It is reported by EachRule when a map key fails the rules specified via Keys().
+type KeyError struct {
+	Err error
+}
+
+// Error returns the error string of KeyError.
+func (e KeyError) Error() string {
+	return "key " + e.Err.Error()
+}
+
+// Unwrap returns the error of the key.
+func (e KeyError) Unwrap() error {
+	return e.Err
+}
+
+// Keys returns a copy of the rule which additionally validates the keys of a map with the given rules.
+// If a key is invalid, the error is reported as a KeyError indexed by the key, and the value associated
+// with the key is not validated. The key rules are ignored when validating slices and arrays.
+// For example,
+//
+//	validation.Each(validation.Min(1)).Keys(is.LowerCase)
+func (r EachRule) Keys(rules ...Rule) EachRule {
+	r.keyRules = rules
+	return r
+}
+
+// Map2 returns a validation rule that validates the keys of a map with keyRules and the associated values
+// with valueRules. It is the same as Each(valueRules...).Keys(keyRules...), so an invalid key is reported
+// as a KeyError. For example,
+//
+//	validation.Map2([]validation.Rule{is.LowerCase}, []validation.Rule{validation.Min(1)})
+func Map2(keyRules, valueRules []Rule) EachRule {
+	return Each(valueRules...).Keys(keyRules...)
+}
+
+// Parallel returns a copy of the rule which validates the elements concurrently using at most the given number
+// of goroutines. If maxWorkers is less than 1, runtime.GOMAXPROCS(0) is used. This is useful for large iterables
+// whose elements are expensive to validate, e.g. by rules doing lookups. The errors are the same as when validating
+// the elements sequentially.
+//
+// The rules, as well as the Validate() methods of the elements, must be safe for concurrent use.
+// If the context is canceled, no more elements are validated and the error of the context is returned
+// as an InternalError. A panic raised while validating an element is propagated to the caller.
+func (r EachRule) Parallel(maxWorkers int) EachRule {
+	if maxWorkers < 1 {
+		maxWorkers = runtime.GOMAXPROCS(0)
+	}
+	r.workers = maxWorkers
+	return r
 }
 
 // Validate loops through the given iterable and calls the Ozzo Validate() method for each value.
@@ -36,36 +94,133 @@ func (r EachRule) ValidateWithContext(ctx context.Context, value interface{}) er
 
 	v := reflect.ValueOf(value)
 	switch v.Kind() {
-	case reflect.Map:
+	case reflect.Map, reflect.Slice, reflect.Array:
+		rules, err := resolveBatchRules(ctx, v, r.rules)
+		if err != nil {
+			return err
+		}
+		r.rules = rules
+		if r.workers > 0 {
+			return r.validateParallel(ctx, v)
+		}
+	default:
+		return errors.New("must be an iterable (map, slice or array)")
+	}
+
+	if v.Kind() == reflect.Map {
 		for _, k := range v.MapKeys() {
-			val := r.getInterface(v.MapIndex(k))
-			var err error
-			if ctx == nil {
-				err = Validate(val, r.rules...)
-			} else {
-				err = ValidateWithContext(ctx, val, r.rules...)
-			}
-			if err != nil {
-				errs[r.getString(k)] = err
+			if err := r.validateEntry(ctx, v, k); err != nil && !errs.add(r.getString(k), err) {
+				break
 			}
 		}
-	case reflect.Slice, reflect.Array:
+	} else {
 		for i := 0; i < v.Len(); i++ {
-			val := r.getInterface(v.Index(i))
-			var err error
-			if ctx == nil {
-				err = Validate(val, r.rules...)
-			} else {
-				err = ValidateWithContext(ctx, val, r.rules...)
+			err := validateRules(ctx, r.getInterface(v.Index(i)), r.rules)
+			if err != nil && !errs.add(strconv.Itoa(i), err) {
+				break
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// validateEntry validates the key (if key rules are specified) and the value of a map entry.
+func (r EachRule) validateEntry(ctx context.Context, v, k reflect.Value) error {
+	if len(r.keyRules) > 0 {
+		if err := validateRules(ctx, r.getInterface(k), r.keyRules); err != nil {
+			if ie, ok := err.(InternalError); !ok || ie.InternalError() == nil {
+				err = KeyError{Err: err}
 			}
-			if err != nil {
-				errs[strconv.Itoa(i)] = err
+			return err
+		}
+	}
+	return validateRules(ctx, r.getInterface(v.MapIndex(k)), r.rules)
+}
+
+// validateParallel validates the elements of a map, slice or array concurrently.
+// The errors are collected in the order of the elements, so that the result is the same as when validating sequentially.
+func (r EachRule) validateParallel(ctx context.Context, v reflect.Value) error {
+	n := v.Len()
+	var keys []reflect.Value
+	if v.Kind() == reflect.Map {
+		keys = v.MapKeys()
+	}
+
+	results := make([]error, n)
+	jobs := make(chan int)
+	var (
+		wg        sync.WaitGroup
+		panicOnce sync.Once
+		panicked  bool
+		panicVal  interface{}
+	)
+	workers := r.workers
+	if workers > n {
+		workers = n
+	}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if p := recover(); p != nil {
+					panicOnce.Do(func() { panicked, panicVal = true, p })
+					for range jobs {
+						// drain the remaining jobs so that the dispatcher is not blocked
+					}
+				}
+			}()
+			for i := range jobs {
+				if keys != nil {
+					results[i] = r.validateEntry(ctx, v, keys[i])
+				} else {
+					results[i] = validateRules(ctx, r.getInterface(v.Index(i)), r.rules)
+				}
 			}
+		}()
+	}
+
+	var done <-chan struct{}
+	if ctx != nil {
+		done = ctx.Done()
+	}
+	canceled := false
+dispatch:
+	for i := 0; i < n; i++ {
+		select {
+		case jobs <- i:
+		case <-done:
+			canceled = true
+			break dispatch
 		}
-	default:
-		return errors.New("must be an iterable (map, slice or array)")
+	}
+	close(jobs)
+	wg.Wait()
+
+	if panicked {
+		panic(panicVal)
+	}
+	if canceled {
+		return NewInternalError(ctx.Err())
 	}
 
+	errs := Errors{}
+	for i, err := range results {
+		if err == nil {
+			continue
+		}
+		key := strconv.Itoa(i)
+		if keys != nil {
+			key = r.getString(keys[i])
+		}
+		if !errs.add(key, err) {
+			break
+		}
+	}
 	if len(errs) > 0 {
 		return errs
 	}
@@ -3,8 +3,13 @@ package validation
 import (
 	"context"
 	"errors"
+	"regexp"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestEach(t *testing.T) {
@@ -72,6 +77,45 @@ func TestEachWithContext(t *testing.T) {
 	}
 }
 
+func TestEachKeys(t *testing.T) {
+	lower := NewStringRule(func(s string) bool { return strings.ToLower(s) == s }, "must be in lower case")
+	r := Each(Min(1)).Keys(lower)
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", map[string]int{}, ""},
+		{"t2", map[string]int{"a": 1, "b": 2}, ""},
+		{"t3", map[string]int{"A": 1, "b": -1}, "A: key must be in lower case; b: must be no less than 1."},
+		{"t4", map[string]int{"A": -1}, "A: key must be in lower case."},
+		{"t5", []int{-1, 1}, "0: must be no less than 1."},
+	}
+	for _, test := range tests {
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := r.Validate(map[string]int{"A": 1, "b": -1})
+	if assert.NotNil(t, err) {
+		errs := err.(Errors)
+		var ke KeyError
+		assert.True(t, errors.As(errs["A"], &ke))
+		assert.EqualError(t, ke.Err, "must be in lower case")
+		assert.False(t, errors.As(errs["b"], &ke))
+	}
+}
+
+func TestMap2(t *testing.T) {
+	lower := NewStringRule(func(s string) bool { return strings.ToLower(s) == s }, "must be in lower case")
+	r := Map2([]Rule{lower}, []Rule{Min(1)})
+
+	assert.Nil(t, r.Validate(map[string]int{"a": 1}))
+	assertError(t, "A: key must be in lower case; b: must be no less than 1.", r.Validate(map[string]int{"A": 1, "b": -1}), "t1")
+	assertError(t, "", Map2(nil, nil).Validate(map[string]int{"A": -1}), "t2")
+}
+
 func TestEachAndBy(t *testing.T) {
 	var byAddr bool
 	var s string
@@ -84,3 +128,158 @@ func TestEachAndBy(t *testing.T) {
 		t.Fatal("slice of pointers does not get passed to `By` function by ref")
 	}
 }
+
+func TestEach_Required(t *testing.T) {
+	s := struct {
+		Items []string
+	}{}
+	validate := func() error {
+		return ValidateStruct(&s, Field(&s.Items, Required, Each(Required, Length(2, 0))))
+	}
+
+	assertError(t, "Items: cannot be blank.", validate(), "t1")
+	s.Items = []string{}
+	assertError(t, "Items: cannot be blank.", validate(), "t2")
+	s.Items = []string{"ab", "", "c"}
+	assertError(t, "Items: (1: cannot be blank; 2: the length must be no less than 2.).", validate(), "t3")
+	s.Items = []string{"ab"}
+	assert.Nil(t, validate(), "t4")
+}
+
+func TestEach_Nested(t *testing.T) {
+	grid := [][]int{{1, 2, 3}, {1, 2, -3}, {1, 2}, {-1, 0, -2}}
+	rule := Each(Length(3, 3), Each(Min(0)))
+
+	err := Validate(grid, rule)
+	assertError(t, "1: (2: must be no less than 0.); 2: the length must be exactly 3; 3: (0: must be no less than 0; 2: must be no less than 0.).", err, "t1")
+	if es, ok := err.(Errors); assert.True(t, ok, "t2") {
+		assert.Equal(t, map[string][]string{
+			"1.2": {"must be no less than 0"},
+			"2":   {"the length must be exactly 3"},
+			"3.0": {"must be no less than 0"},
+			"3.2": {"must be no less than 0"},
+		}, es.ToMultiMap(), "t2")
+	}
+
+	s := struct {
+		Grid [][]int `json:"grid"`
+	}{Grid: grid[:2]}
+	err = ValidateStruct(&s, Field(&s.Grid, Length(2, 2), rule))
+	assertError(t, "grid: (1: (2: must be no less than 0.).).", err, "t3")
+	if es, ok := err.(Errors); assert.True(t, ok, "t4") {
+		assert.Equal(t, map[string][]string{"grid.1.2": {"must be no less than 0"}}, es.ToMultiMap(), "t4")
+	}
+
+	assert.Nil(t, Validate([][]int{{0, 1, 2}}, rule), "t5")
+	assert.Nil(t, Validate([][]int{}, rule), "t6")
+}
+
+func TestEach_Parallel(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value interface{}
+	}{
+		{"t1", []string{"a", "", "b", "", "c"}},
+		{"t2", []string{"a", "b"}},
+		{"t3", [3]string{"", "a", ""}},
+		{"t4", map[string]string{"a": "", "b": "x", "c": ""}},
+		{"t5", []string{}},
+		{"t6", map[string]string{}},
+		{"t7", "abc"},
+	}
+
+	for _, test := range tests {
+		expected := Validate(test.value, Each(Required))
+		for _, workers := range []int{0, 1, 2, 10} {
+			err := Validate(test.value, Each(Required).Parallel(workers))
+			assert.Equal(t, expected, err, "%v %v", test.tag, workers)
+		}
+	}
+
+	err := Validate(map[string]int{"A": 1, "b": 0}, Each(Required).Keys(Match(regexp.MustCompile("^[a-z]$"))).Parallel(2))
+	assert.EqualError(t, err, "A: key must be in a valid format; b: cannot be blank.")
+}
+
+func TestEach_ParallelMaxErrors(t *testing.T) {
+	MaxErrors(2)
+	defer MaxErrors(0)
+
+	value := []string{"a", "", "", "b", "", ""}
+	err := Validate(value, Each(Required).Parallel(3))
+	assert.Equal(t, Validate(value, Each(Required)), err)
+	assert.EqualError(t, err, "...: there are more errors; 1: cannot be blank; 2: cannot be blank.")
+}
+
+func TestEach_ParallelConcurrency(t *testing.T) {
+	var running, maxRunning int32
+	rule := By(func(value interface{}) error {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return nil
+	})
+
+	assert.Nil(t, Validate(make([]int, 8), Each(rule).Parallel(4)))
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxRunning), int32(4))
+	assert.Greater(t, atomic.LoadInt32(&maxRunning), int32(1))
+}
+
+type eachContextKey struct{}
+
+func TestEach_ParallelContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var count int32
+	rule := WithContext(func(ctx context.Context, value interface{}) error {
+		if atomic.AddInt32(&count, 1) == 2 {
+			cancel()
+		}
+		if ctx.Value(eachContextKey{}) != "value" {
+			return errors.New("context not propagated")
+		}
+		return nil
+	})
+
+	err := ValidateWithContext(context.WithValue(ctx, eachContextKey{}, "value"), make([]int, 100), Each(rule).Parallel(1))
+	if assert.NotNil(t, err) {
+		ie, ok := err.(InternalError)
+		if assert.True(t, ok) {
+			assert.Equal(t, context.Canceled, ie.InternalError())
+		}
+	}
+	assert.Less(t, atomic.LoadInt32(&count), int32(100))
+}
+
+func TestEach_ParallelPanic(t *testing.T) {
+	rule := By(func(value interface{}) error {
+		if value.(int) == 3 {
+			panic("boom")
+		}
+		return nil
+	})
+	assert.PanicsWithValue(t, "boom", func() {
+		_ = Validate([]int{1, 2, 3, 4, 5, 6}, Each(rule).Parallel(2))
+	})
+}
+
+func TestEach_RecoverPanics(t *testing.T) {
+	rule := By(func(value interface{}) error {
+		if value.(int) == 2 {
+			panic("boom")
+		}
+		return nil
+	})
+
+	RecoverPanics = true
+	defer func() { RecoverPanics = false }()
+
+	err := Validate([]int{1, 2, 3}, Each(rule))
+	assert.EqualError(t, err, "1: internal validation error.")
+	err = Validate([]int{1, 2, 3}, Each(rule).Parallel(2))
+	assert.EqualError(t, err, "1: internal validation error.")
+}
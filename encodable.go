@@ -0,0 +1,63 @@
+package validation
+
+import (
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+)
+
+// ErrNotEncodable is the error that returns when a string cannot be encoded into an encoding.
+var ErrNotEncodable = NewError("validation_not_encodable", "contains characters not representable in the target encoding")
+
+// EncodableRule is a validation rule that checks if a string can be encoded losslessly into an encoding.
+type EncodableRule struct {
+	enc encoding.Encoding
+	err Error
+}
+
+// Encodable returns a validation rule that checks if a UTF-8 string or byte slice can be encoded losslessly
+// into the given character encoding, e.g. before writing it to a legacy system:
+//
+//	err := validation.Validate(name, validation.Encodable(charmap.ISO8859_1))
+//
+// A value is invalid if it contains a character that the encoding cannot represent, or if it is not valid UTF-8.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Encodable(enc encoding.Encoding) EncodableRule {
+	return EncodableRule{
+		enc: enc,
+		err: ErrNotEncodable,
+	}
+}
+
+// Error sets the error message for the rule.
+func (r EncodableRule) Error(message string) EncodableRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r EncodableRule) ErrorObject(err Error) EncodableRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r EncodableRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	if !utf8.ValidString(str) {
+		return r.err
+	}
+	if _, err := r.enc.NewEncoder().String(str); err != nil {
+		return r.err
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/unicode"
+)
+
+func TestEncodable(t *testing.T) {
+	var s *string
+	tests := []struct {
+		tag   string
+		enc   encoding.Encoding
+		value interface{}
+		err   string
+	}{
+		{"t1", charmap.ISO8859_1, "Cafe\u0301", "contains characters not representable in the target encoding"},
+		{"t2", charmap.ISO8859_1, "Caf\u00e9 cr\u00e8me", ""},
+		{"t3", charmap.ISO8859_1, "\u20ac5", "contains characters not representable in the target encoding"},
+		{"t4", charmap.Windows1252, "\u20ac5", ""},
+		{"t5", charmap.ISO8859_1, "\u65e5\u672c", "contains characters not representable in the target encoding"},
+		{"t6", japanese.ShiftJIS, "\u65e5\u672c", ""},
+		{"t7", unicode.UTF8, "\u65e5\u672c", ""},
+		{"t8", charmap.ISO8859_1, "abc\xff", "contains characters not representable in the target encoding"},
+		{"t9", charmap.ISO8859_1, []byte("abc"), ""},
+		{"t10", charmap.ISO8859_1, "", ""},
+		{"t11", charmap.ISO8859_1, s, ""},
+		{"t12", charmap.ISO8859_1, 123, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		err := Encodable(test.enc).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func Test_EncodableRule_Error(t *testing.T) {
+	r := Encodable(charmap.ISO8859_1)
+	assert.Equal(t, "contains characters not representable in the target encoding", r.err.Message())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestEncodableRule_ErrorObject(t *testing.T) {
+	r := Encodable(charmap.ISO8859_1)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,75 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+)
+
+var (
+	// ErrTooManyEntries is the error that returns when a map or slice has more entries than allowed.
+	ErrTooManyEntries = NewError("validation_too_many_entries", "must not contain more than {{.max}} entries")
+	// ErrTooFewEntries is the error that returns when a map or slice has fewer entries than required.
+	ErrTooFewEntries = NewError("validation_too_few_entries", "must contain at least {{.min}} entries")
+)
+
+// EntriesRule is a validation rule that checks the number of entries in a map, slice or array.
+type EntriesRule struct {
+	min, max int
+	err      Error
+}
+
+// MaxEntries returns a validation rule that checks if a map, slice or array has no more than the given number
+// of entries. It is meant for bounding user-supplied collections, such as custom metadata, and unlike Length
+// it reports the limit in terms of entries.
+// This rule should only be used for validating maps, slices and arrays.
+// An empty value is considered valid.
+func MaxEntries(n int) EntriesRule {
+	return EntriesRule{
+		min: -1,
+		max: n,
+		err: ErrTooManyEntries.SetParams(map[string]interface{}{"max": n}),
+	}
+}
+
+// MinEntries returns a validation rule that checks if a map, slice or array has at least the given number of entries.
+// This rule should only be used for validating maps, slices and arrays.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func MinEntries(n int) EntriesRule {
+	return EntriesRule{
+		min: n,
+		max: -1,
+		err: ErrTooFewEntries.SetParams(map[string]interface{}{"min": n}),
+	}
+}
+
+// Error sets the error message for the rule.
+func (r EntriesRule) Error(message string) EntriesRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r EntriesRule) ErrorObject(err Error) EntriesRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r EntriesRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Array:
+	default:
+		return errors.New("must be a map, a slice or an array")
+	}
+
+	if n := v.Len(); r.max >= 0 && n > r.max || r.min >= 0 && n < r.min {
+		return r.err
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxEntries(t *testing.T) {
+	var m map[string]string
+	tests := []struct {
+		tag   string
+		max   int
+		value interface{}
+		err   string
+	}{
+		{"t1", 2, map[string]string{"a": "1", "b": "2"}, ""},
+		{"t2", 2, map[string]string{"a": "1", "b": "2", "c": "3"}, "must not contain more than 2 entries"},
+		{"t3", 2, []int{1, 2, 3}, "must not contain more than 2 entries"},
+		{"t4", 3, [3]int{1, 2, 3}, ""},
+		{"t5", 2, &map[string]int{"a": 1}, ""},
+		{"t6", 0, map[string]int{"a": 1}, "must not contain more than 0 entries"},
+		{"t7", 2, map[string]int{}, ""},
+		{"t8", 2, m, ""},
+		{"t9", 2, nil, ""},
+		{"t10", 2, "abc", "must be a map, a slice or an array"},
+	}
+
+	for _, test := range tests {
+		err := MaxEntries(test.max).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestMinEntries(t *testing.T) {
+	tests := []struct {
+		tag   string
+		min   int
+		value interface{}
+		err   string
+	}{
+		{"t1", 2, map[string]string{"a": "1", "b": "2"}, ""},
+		{"t2", 2, map[string]string{"a": "1"}, "must contain at least 2 entries"},
+		{"t3", 2, []int{1}, "must contain at least 2 entries"},
+		{"t4", 2, []int{}, ""},
+		{"t5", 2, 10, "must be a map, a slice or an array"},
+	}
+
+	for _, test := range tests {
+		err := MinEntries(test.min).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func Test_EntriesRule_Error(t *testing.T) {
+	r := MaxEntries(1)
+	assert.Equal(t, "must not contain more than {{.max}} entries", r.err.Message())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+	assert.Equal(t, "123", r.Validate([]int{1, 2}).Error())
+}
+
+func TestEntriesRule_ErrorObject(t *testing.T) {
+	r := MinEntries(2)
+
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
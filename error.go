@@ -7,9 +7,11 @@ package validation
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
+	"sync/atomic"
 	"text/template"
 )
 
@@ -30,12 +32,21 @@ type (
 		code    string
 		message string
 		params  map[string]interface{}
+		value   interface{}
 	}
 
 	// Errors represents the validation errors that are indexed by struct field names, map or slice keys.
 	// values are Error or Errors (for map, slice and array error value is Errors).
 	Errors map[string]error
 
+	// FieldMessage is a message of a validation error together with the path of the field it is reported for.
+	FieldMessage struct {
+		// Field is the dotted path of the field, as used by Errors.ToMultiMap (e.g. "address.zip").
+		Field string
+		// Message is the error message.
+		Message string
+	}
+
 	// InternalError represents an error that should NOT be treated as a validation error.
 	InternalError interface {
 		error
@@ -47,6 +58,44 @@ type (
 	}
 )
 
+// TruncatedErrorsKey is the key under which ErrTooManyErrors is stored in Errors
+// when errors are no longer collected because the limit set by MaxErrors is reached.
+const TruncatedErrorsKey = "..."
+
+var (
+	// ErrTooManyErrors is the error that indicates more errors exist than those collected.
+	ErrTooManyErrors = NewError("validation_too_many_errors", "there are more errors")
+
+	includeValue atomic.Bool
+	maxErrors    atomic.Int64
+)
+
+// MaxErrors sets the maximum number of errors collected into a single Errors by ValidateStruct, Map, Each
+// and the validation of maps/slices/arrays of validatables. Once the limit is reached, the remaining
+// elements are not validated and the Errors is marked as truncated by storing ErrTooManyErrors under
+// TruncatedErrorsKey, so a truncated Errors holds max+1 entries. Zero, the default, means there is no limit.
+func MaxErrors(max int) {
+	maxErrors.Store(int64(max))
+}
+
+// IncludeValueInErrors specifies whether Validate, ValidateWithContext and ValidateStruct record the offending value
+// in the ErrorObject returned by a failing rule, so that it can be retrieved by ErrorObject.Value() for troubleshooting.
+// The value is never included in the error message. It is disabled by default, and should not be enabled in production
+// if the values may contain sensitive data. Some rules, such as NotContainsAny, also report extra details in the error
+// parameters when it is enabled.
+func IncludeValueInErrors(include bool) {
+	includeValue.Store(include)
+}
+
+// withValue records the given value in a validation error if IncludeValueInErrors is enabled.
+func withValue(err error, value interface{}) error {
+	if e, ok := err.(ErrorObject); ok && includeValue.Load() {
+		value, _ = Indirect(value)
+		return e.SetValue(value)
+	}
+	return err
+}
+
 // NewInternalError wraps a given error into an InternalError.
 func NewInternalError(err error) InternalError {
 	return internalError{error: err}
@@ -100,6 +149,18 @@ func (e ErrorObject) Message() string {
 	return e.message
 }
 
+// SetValue sets the value that failed the validation.
+func (e ErrorObject) SetValue(value interface{}) Error {
+	e.value = value
+	return e
+}
+
+// Value returns the value that failed the validation. It is only populated when IncludeValueInErrors is enabled,
+// and is never included in the error message.
+func (e ErrorObject) Value() interface{} {
+	return e.value
+}
+
 // Error returns the error message.
 func (e ErrorObject) Error() string {
 	if len(e.params) == 0 {
@@ -145,6 +206,9 @@ func (es Errors) Error() string {
 func (es Errors) MarshalJSON() ([]byte, error) {
 	errs := map[string]interface{}{}
 	for key, err := range es {
+		if isTruncationMarker(key, err) {
+			continue
+		}
 		if ms, ok := err.(json.Marshaler); ok {
 			errs[key] = ms
 		} else {
@@ -154,6 +218,133 @@ func (es Errors) MarshalJSON() ([]byte, error) {
 	return json.Marshal(errs)
 }
 
+// ToMultiMap converts the Errors into a map of error messages indexed by field paths.
+// Nested Errors are flattened using dotted paths (e.g. "address.zip"). An error wrapping multiple errors
+// (e.g. one created by errors.Join) is expanded into one message per wrapped error.
+func (es Errors) ToMultiMap() map[string][]string {
+	res := map[string][]string{}
+	es.flatten("", joinErrorPath, res)
+	return res
+}
+
+// ToJSONPointers converts the Errors into a map of error messages indexed by RFC 6901 JSON pointers built from
+// the keys of the nested Errors (e.g. "/items/0/name"), as used by JSON Patch and by some form libraries.
+// Multiple messages of the same pointer, such as those of an error created by errors.Join, are joined with "; ".
+func (es Errors) ToJSONPointers() map[string]string {
+	messages := map[string][]string{}
+	es.flatten("", joinJSONPointer, messages)
+	res := make(map[string]string, len(messages))
+	for pointer, msgs := range messages {
+		res[pointer] = strings.Join(msgs, "; ")
+	}
+	return res
+}
+
+// FieldMessages lists the messages of a validation error sorted by field, so that they can be converted into
+// the error format of a transport, such as the field violations of gRPC. Each message of an Errors is listed
+// with the dotted path of the error, as returned by Errors.ToMultiMap, and a single validation error is listed
+// with an empty field. It returns false if the error is nil or is not a validation error, e.g. an InternalError.
+func FieldMessages(err error) ([]FieldMessage, bool) {
+	return fieldMessages(err, joinErrorPath)
+}
+
+// fieldMessages lists the messages of a validation error sorted by the paths built with the join function.
+func fieldMessages(err error, join func(prefix, key string) string) ([]FieldMessage, bool) {
+	var messages map[string][]string
+	var es Errors
+	var e Error
+	switch {
+	case errors.As(err, &es):
+		messages = map[string][]string{}
+		es.flatten("", join, messages)
+	case errors.As(err, &e):
+		messages = map[string][]string{"": {e.Error()}}
+	default:
+		return nil, false
+	}
+
+	fields := make([]string, 0, len(messages))
+	for field := range messages {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	var res []FieldMessage
+	for _, field := range fields {
+		for _, message := range messages[field] {
+			res = append(res, FieldMessage{Field: field, Message: message})
+		}
+	}
+	return res, true
+}
+
+// flatten collects the messages of the errors into res, indexed by the paths built with the join function.
+func (es Errors) flatten(prefix string, join func(prefix, key string) string, res map[string][]string) {
+	for key, err := range es {
+		if !isTruncationMarker(key, err) {
+			flattenError(join(prefix, key), err, join, res)
+		}
+	}
+}
+
+// isTruncationMarker checks if the error is the one stored under TruncatedErrorsKey when MaxErrors is reached.
+// The marker is not the error of a field, so it is left out when converting the Errors for clients.
+func isTruncationMarker(key string, err error) bool {
+	e, ok := err.(Error)
+	return key == TruncatedErrorsKey && ok && e.Code() == ErrTooManyErrors.Code()
+}
+
+// joinErrorPath returns the dotted path of an error with the given key nested under the given path.
+func joinErrorPath(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	if key == "" {
+		return prefix
+	}
+	return prefix + "." + key
+}
+
+// joinJSONPointer returns the RFC 6901 JSON pointer of an error with the given key nested under the given pointer.
+func joinJSONPointer(prefix, key string) string {
+	if key == "" {
+		return prefix
+	}
+	return prefix + "/" + strings.ReplaceAll(strings.ReplaceAll(key, "~", "~0"), "/", "~1")
+}
+
+func flattenError(path string, err error, join func(prefix, key string) string, res map[string][]string) {
+	switch e := err.(type) {
+	case nil:
+	case Errors:
+		e.flatten(path, join, res)
+	case interface{ Unwrap() []error }:
+		for _, err := range e.Unwrap() {
+			flattenError(path, err, join, res)
+		}
+	default:
+		res[path] = append(res[path], err.Error())
+	}
+}
+
+// Truncated returns whether some errors were not collected because MaxErrors was reached.
+// This is only reported by Truncated: MarshalJSON, ToMultiMap and ToJSONPointers leave the truncation out.
+func (es Errors) Truncated() bool {
+	_, ok := es[TruncatedErrorsKey]
+	return ok
+}
+
+// add adds an error with the given key unless MaxErrors is reached, in which case the Errors is marked
+// as truncated. It returns false if no more errors should be added.
+func (es Errors) add(key string, err error) bool {
+	if max := maxErrors.Load(); max > 0 && int64(len(es)) >= max {
+		es[TruncatedErrorsKey] = ErrTooManyErrors
+		return false
+	}
+	es[key] = err
+	return true
+}
+
 // Filter removes all nils from Errors and returns back the updated Errors as an error.
 // If the length of Errors becomes 0, it will return nil.
 func (es Errors) Filter() error {
@@ -168,6 +359,37 @@ func (es Errors) Filter() error {
 	return es
 }
 
+// FilterFunc returns the Errors that contain only the errors for which the given function returns true.
+// The function is called for each error that is not an Errors, with the key of the error as the first argument.
+// The keys of nested Errors are given as dotted paths (e.g. "address.zip"), in the same way ToMultiMap does.
+// Nested Errors are filtered recursively, and those that become empty are removed together with nil errors.
+// If no error is left, it will return nil. The original Errors is not modified.
+func (es Errors) FilterFunc(f func(key string, err error) bool) error {
+	if res := es.filterFunc("", f); len(res) > 0 {
+		return res
+	}
+	return nil
+}
+
+func (es Errors) filterFunc(prefix string, f func(key string, err error) bool) Errors {
+	res := Errors{}
+	for key, err := range es {
+		path := joinErrorPath(prefix, key)
+		switch e := err.(type) {
+		case nil:
+		case Errors:
+			if nested := e.filterFunc(path, f); len(nested) > 0 {
+				res[key] = nested
+			}
+		default:
+			if f(path, err) {
+				res[key] = err
+			}
+		}
+	}
+	return res
+}
+
 // NewError create new validation error.
 func NewError(code, message string) Error {
 	return ErrorObject{
@@ -5,7 +5,9 @@
 package validation
 
 import (
+	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -47,6 +49,86 @@ func TestErrors_MarshalMessage(t *testing.T) {
 	assert.Equal(t, "{\"A\":\"A1\",\"B\":{\"2\":\"B1\"}}", string(errsJSON))
 }
 
+func TestErrors_ToMultiMap(t *testing.T) {
+	errs := Errors{
+		"email": errors.Join(errors.New("cannot be blank"), errors.New("must be a valid email address")),
+		"name":  errors.New("A1"),
+		"address": Errors{
+			"zip": errors.New("B1"),
+			"":    errors.New("B2"),
+			"lines": Errors{
+				"0": errors.New("C1"),
+			},
+		},
+		"nil": nil,
+	}
+	assert.Equal(t, map[string][]string{
+		"email":           {"cannot be blank", "must be a valid email address"},
+		"name":            {"A1"},
+		"address":         {"B2"},
+		"address.zip":     {"B1"},
+		"address.lines.0": {"C1"},
+	}, errs.ToMultiMap())
+
+	assert.Equal(t, map[string][]string{}, Errors{}.ToMultiMap())
+}
+
+func TestErrors_ToJSONPointers(t *testing.T) {
+	errs := Errors{
+		"email": errors.Join(errors.New("cannot be blank"), errors.New("must be a valid email address")),
+		"name":  errors.New("A1"),
+		"items": Errors{
+			"0": Errors{
+				"name": errors.New("B1"),
+				"":     errors.New("B2"),
+			},
+		},
+		"a/b~c": errors.New("C1"),
+		"nil":   nil,
+	}
+	assert.Equal(t, map[string]string{
+		"/email":        "cannot be blank; must be a valid email address",
+		"/name":         "A1",
+		"/items/0":      "B2",
+		"/items/0/name": "B1",
+		"/a~1b~0c":      "C1",
+	}, errs.ToJSONPointers())
+
+	assert.Equal(t, map[string]string{"": "A1"}, Errors{"": errors.New("A1")}.ToJSONPointers())
+	assert.Equal(t, map[string]string{}, Errors{}.ToJSONPointers())
+}
+
+func TestFieldMessages(t *testing.T) {
+	errs := Errors{
+		"name": ErrRequired,
+		"address": Errors{
+			"zip":    errors.Join(ErrRequired, ErrNotNilRequired),
+			"street": nil,
+		},
+	}
+	messages, ok := FieldMessages(errs)
+	assert.True(t, ok)
+	assert.Equal(t, []FieldMessage{
+		{Field: "address.zip", Message: "cannot be blank"},
+		{Field: "address.zip", Message: "is required"},
+		{Field: "name", Message: "cannot be blank"},
+	}, messages)
+
+	messages, ok = FieldMessages(ErrRequired)
+	assert.True(t, ok)
+	assert.Equal(t, []FieldMessage{{Field: "", Message: "cannot be blank"}}, messages)
+
+	messages, ok = FieldMessages(Errors{})
+	assert.True(t, ok)
+	assert.Empty(t, messages)
+
+	for _, err := range []error{nil, errors.New("abc"), NewInternalError(errors.New("abc"))} {
+		messages, ok = FieldMessages(err)
+		assert.False(t, ok)
+		assert.Nil(t, messages)
+	}
+}
+
 func TestErrors_Filter(t *testing.T) {
 	errs := Errors{
 		"B": errors.New("B1"),
@@ -70,6 +152,84 @@ func TestErrors_Filter(t *testing.T) {
 	assert.Nil(t, errs.Filter())
 }
 
+func TestErrors_FilterFunc(t *testing.T) {
+	errs := Errors{
+		"name":  errors.New("N1"),
+		"email": errors.New("E1"),
+		"phone": nil,
+		"address": Errors{
+			"zip":   errors.New("Z1"),
+			"state": errors.New("S1"),
+		},
+		"tags": Errors{
+			"0": errors.New("T1"),
+		},
+	}
+	submitted := map[string]bool{"name": true, "address.zip": true, "phone": true}
+
+	err := errs.FilterFunc(func(key string, err error) bool {
+		return submitted[key]
+	})
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "address: (zip: Z1.); name: N1.", err.Error())
+	}
+	// the original errors are kept
+	assert.Len(t, errs, 5)
+	assert.Len(t, errs["address"], 2)
+
+	err = errs.FilterFunc(func(key string, err error) bool {
+		return strings.HasPrefix(key, "tags.")
+	})
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "tags: (0: T1.).", err.Error())
+	}
+
+	err = errs.FilterFunc(func(key string, err error) bool {
+		return err.Error() == "S1"
+	})
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "address: (state: S1.).", err.Error())
+	}
+
+	assert.Nil(t, errs.FilterFunc(func(string, error) bool { return false }))
+	assert.Nil(t, Errors{}.FilterFunc(func(string, error) bool { return true }))
+}
+
+func TestMaxErrors(t *testing.T) {
+	MaxErrors(2)
+	defer MaxErrors(0)
+
+	err := Validate([]string{"", "a", "", "", ""}, Each(Required))
+	assert.EqualError(t, err, "...: there are more errors; 0: cannot be blank; 2: cannot be blank.")
+	assert.True(t, err.(Errors).Truncated())
+
+	// the truncation is only reported by Truncated when converting the errors
+	errs := Errors{"0": err.(Errors)}
+	assert.Equal(t, map[string][]string{"0.0": {"cannot be blank"}, "0.2": {"cannot be blank"}}, errs.ToMultiMap())
+	assert.Equal(t, map[string]string{"/0/0": "cannot be blank", "/0/2": "cannot be blank"}, errs.ToJSONPointers())
+	data, _ := errs.MarshalJSON()
+	assert.JSONEq(t, `{"0":{"0":"cannot be blank","2":"cannot be blank"}}`, string(data))
+	messages, _ := FieldMessages(err)
+	assert.Equal(t, []FieldMessage{{Field: "0", Message: "cannot be blank"}, {Field: "2", Message: "cannot be blank"}}, messages)
+
+	err = Validate([]string{"", "a", ""}, Each(Required))
+	assert.EqualError(t, err, "0: cannot be blank; 2: cannot be blank.")
+	assert.False(t, err.(Errors).Truncated())
+
+	err = Validate(map[string]int{"a": 1, "b": 2, "c": 3}, Map())
+	if assert.NotNil(t, err) {
+		assert.Len(t, err.(Errors), 3)
+		assert.True(t, err.(Errors).Truncated())
+	}
+
+	err = Validate([]String123{"a", "b", "c"})
+	assert.EqualError(t, err, "...: there are more errors; 0: error 123; 1: error 123.")
+
+	m := Model1{}
+	err = ValidateStruct(&m, Field(&m.A, Required), Field(&m.B, Required), Field(&m.G, Required))
+	assert.EqualError(t, err, "...: there are more errors; A: cannot be blank; B: cannot be blank.")
+}
+
 func TestErrorObject_SetCode(t *testing.T) {
 	err := NewError("A", "msg").(ErrorObject)
 
@@ -185,3 +345,43 @@ func TestValidationError(t *testing.T) {
 
 	assert.Equal(t, err.Params(), params)
 }
+
+func TestIncludeValueInErrors(t *testing.T) {
+	name := "ab"
+	err := Validate(&name, Length(3, 5))
+	if assert.NotNil(t, err) {
+		assert.Nil(t, err.(ErrorObject).Value())
+	}
+
+	IncludeValueInErrors(true)
+	defer IncludeValueInErrors(false)
+
+	err = Validate(&name, Length(3, 5))
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "ab", err.(ErrorObject).Value())
+		assert.Equal(t, "the length must be between 3 and 5", err.Error())
+	}
+
+	err = ValidateWithContext(context.Background(), 10, Max(5))
+	if assert.NotNil(t, err) {
+		assert.Equal(t, 10, err.(ErrorObject).Value())
+	}
+
+	s := struct {
+		Name string
+		Tags []string
+	}{Name: "x", Tags: []string{"a", ""}}
+	err = ValidateStruct(&s, Field(&s.Name, Length(3, 5)), Field(&s.Tags, Each(Required)))
+	if es, ok := err.(Errors); assert.True(t, ok) {
+		assert.Equal(t, "x", es["Name"].(ErrorObject).Value())
+		assert.Equal(t, "", es["Tags"].(Errors)["1"].(ErrorObject).Value())
+		assert.Equal(t, "Name: the length must be between 3 and 5; Tags: (1: cannot be blank.).", err.Error())
+	}
+
+	// errors that are not ErrorObject are kept
+	err = Validate("abc", By(func(interface{}) error { return errors.New("abc") }))
+	assert.EqualError(t, err, "abc")
+
+	// the pre-defined errors are not modified
+	assert.Nil(t, ErrRequired.(ErrorObject).Value())
+}
@@ -0,0 +1,95 @@
+package validation
+
+// validateFast validates a string, an int, or a pointer to either of them without using reflection,
+// provided that all the rules are among Skip, Optional, Required, NilOrNotEmpty, Length, RuneLength, Min and Max.
+// These are by far the most common combinations, and avoiding reflection saves the allocations made by
+// Indirect for pointers. It returns false without validating if the value or any of the rules is not supported,
+// in which case the regular validation should be performed. The results are the same in both cases.
+func validateFast(value interface{}, rules []Rule) (bool, error) {
+	var (
+		str             string
+		num             int64
+		isString, isNil bool
+	)
+	switch v := value.(type) {
+	case string:
+		str, isString = v, true
+	case *string:
+		if isNil = v == nil; !isNil {
+			str = *v
+		}
+		isString = true
+	case int:
+		num = int64(v)
+	case *int:
+		if isNil = v == nil; !isNil {
+			num = int64(*v)
+		}
+	default:
+		return false, nil
+	}
+
+	for _, rule := range rules {
+		if !isFastRule(rule, isString) {
+			return false, nil
+		}
+	}
+
+	isEmpty := isNil || isString && str == "" || !isString && num == 0
+	for _, rule := range rules {
+		var err error
+		switch r := rule.(type) {
+		case skipRule:
+			if r.skip {
+				return true, nil
+			}
+		case optionalRule:
+			if isEmpty {
+				return true, nil
+			}
+		case RequiredRule:
+			if r.condition {
+				err = r.check(isNil, isEmpty)
+			}
+		case LengthRule:
+			if !isEmpty {
+				err = r.checkLength(r.stringLength(str))
+			}
+		case ThresholdRule:
+			if t, _ := intThreshold(r.threshold); !isEmpty && !r.compareInt(t, num) {
+				err = r.err.SetParams(map[string]interface{}{"threshold": r.threshold})
+			}
+		}
+		if err != nil {
+			return true, withValue(err, value)
+		}
+	}
+	return true, nil
+}
+
+// isFastRule checks if a rule can be applied by validateFast to a string (if isString is true) or an int.
+func isFastRule(rule Rule, isString bool) bool {
+	switch r := rule.(type) {
+	case skipRule, optionalRule:
+		return true
+	case RequiredRule:
+		return len(r.with) == 0 && len(r.without) == 0
+	case LengthRule:
+		return isString
+	case ThresholdRule:
+		_, ok := intThreshold(r.threshold)
+		return ok && !isString
+	}
+	return false
+}
+
+// intThreshold returns the threshold of a ThresholdRule if it is an int or int64.
+func intThreshold(threshold interface{}) (int64, bool) {
+	switch t := threshold.(type) {
+	case int:
+		return int64(t), true
+	case int64:
+		return t, true
+	}
+	return 0, false
+}
@@ -0,0 +1,145 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateFast(t *testing.T) {
+	empty, short, long, padded, multibyte := "", "a", "hello world", "  ab  ", "äöü"
+	zero, small, big := 0, 1, 100
+	var nilStr *string
+	var nilInt *int
+
+	values := []interface{}{
+		"", "a", "hello", "hello world", "  ab  ", "äöü",
+		&empty, &short, &long, &padded, &multibyte, nilStr,
+		0, 1, 5, 100, -3, &zero, &small, &big, nilInt,
+	}
+	ruleSets := [][]Rule{
+		nil,
+		{Required},
+		{NilOrNotEmpty},
+		{Required.When(false)},
+		{Required.Error("custom")},
+		{Skip, Required},
+		{Skip.When(false), Required},
+		{Optional, Required},
+		{Required, Length(2, 5)},
+		{Length(0, 3)},
+		{Length(3, 3)},
+		{Length(0, 0)},
+		{RuneLength(3, 3)},
+		{Length(2, 2).TrimSpace()},
+		{Required, Min(2)},
+		{Max(10).Exclusive()},
+		{Min(int64(2)), Max(int64(10))},
+		{Min(0).Exclusive(), Max(99)},
+	}
+
+	for _, rules := range ruleSets {
+		for _, value := range values {
+			ok, err := validateFast(value, rules)
+			if !ok {
+				continue
+			}
+			expected := validateRegular(value, rules)
+			assert.Equal(t, expected, err, "%#v %#v", value, rules)
+		}
+	}
+}
+
+func TestValidateFast_Unsupported(t *testing.T) {
+	s, n := "abc", 5
+	tests := []struct {
+		tag   string
+		value interface{}
+		rules []Rule
+	}{
+		{"t1", int64(5), []Rule{Required}},
+		{"t2", MyString("abc"), []Rule{Required}},
+		{"t3", &s, []Rule{Required, Match(nil)}},
+		{"t4", s, []Rule{Min(2)}},
+		{"t5", n, []Rule{Length(1, 2)}},
+		{"t6", n, []Rule{Min(2.5)}},
+		{"t7", n, []Rule{Min(&n)}},
+		{"t8", s, []Rule{RequiredWith(&n)}},
+	}
+
+	for _, test := range tests {
+		ok, err := validateFast(test.value, test.rules)
+		assert.False(t, ok, test.tag)
+		assert.Nil(t, err, test.tag)
+	}
+}
+
+func TestValidateFast_IncludeValue(t *testing.T) {
+	IncludeValueInErrors(true)
+	defer IncludeValueInErrors(false)
+
+	s := "a"
+	err := Validate(&s, Length(2, 5))
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "a", err.(ErrorObject).Value())
+	}
+}
+
+// validateRegular applies the rules the way Validate does without the fast path.
+func validateRegular(value interface{}, rules []Rule) error {
+	for _, rule := range rules {
+		if s, ok := rule.(skipRule); ok && s.skip {
+			return nil
+		}
+		if _, ok := rule.(optionalRule); ok && isEmptyValue(value) {
+			return nil
+		}
+		if err := rule.Validate(value); err != nil {
+			return withValue(err, value)
+		}
+	}
+	return nil
+}
+
+func BenchmarkValidate_String(b *testing.B) {
+	b.ReportAllocs()
+	rules := []Rule{Required, Length(2, 10)}
+	for i := 0; i < b.N; i++ {
+		_ = Validate("hello", rules...)
+	}
+}
+
+func BenchmarkValidate_StringPointer(b *testing.B) {
+	b.ReportAllocs()
+	rules := []Rule{Required, Length(2, 10)}
+	s := "hello"
+	for i := 0; i < b.N; i++ {
+		_ = Validate(&s, rules...)
+	}
+}
+
+func BenchmarkValidate_Int(b *testing.B) {
+	b.ReportAllocs()
+	rules := []Rule{Required, Min(1), Max(10)}
+	for i := 0; i < b.N; i++ {
+		_ = Validate(5, rules...)
+	}
+}
+
+func BenchmarkValidate_IntPointer(b *testing.B) {
+	b.ReportAllocs()
+	rules := []Rule{Required, Min(1), Max(10)}
+	n := 5
+	for i := 0; i < b.N; i++ {
+		_ = Validate(&n, rules...)
+	}
+}
+
+func BenchmarkValidate_Regular(b *testing.B) {
+	b.ReportAllocs()
+	rules := []Rule{Required, Length(2, 10)}
+	s := MyString("hello")
+	for i := 0; i < b.N; i++ {
+		_ = Validate(&s, rules...)
+	}
+}
@@ -0,0 +1,82 @@
+package validation
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+)
+
+// ErrNotFinite is the error that returns when a number is NaN or infinite.
+var ErrNotFinite = NewError("validation_not_finite", "must be a finite number")
+
+// Finite is a validation rule that checks if a float is neither NaN nor an infinity, so that poisoned values
+// do not propagate into calculations and storage. The number can be of any float or complex type, or a big.Float;
+// integers and other big numbers are always finite.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+var Finite = FiniteRule{}
+
+// FiniteRule is a validation rule that checks if a number is finite.
+type FiniteRule struct {
+	err Error
+}
+
+// Error sets the error message for the rule.
+func (r FiniteRule) Error(message string) FiniteRule {
+	r.err = r.defaultError().SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r FiniteRule) ErrorObject(err Error) FiniteRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r FiniteRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case big.Float:
+		if v.IsInf() {
+			return r.defaultError()
+		}
+		return nil
+	case big.Int, big.Rat:
+		return nil
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return nil
+	case reflect.Float32, reflect.Float64:
+		if !isFinite(rv.Float()) {
+			return r.defaultError()
+		}
+		return nil
+	case reflect.Complex64, reflect.Complex128:
+		if c := rv.Complex(); !isFinite(real(c)) || !isFinite(imag(c)) {
+			return r.defaultError()
+		}
+		return nil
+	}
+	return fmt.Errorf("type not supported: %v", rv.Type())
+}
+
+// defaultError returns the error set for the rule, or ErrNotFinite.
+func (r FiniteRule) defaultError() Error {
+	if r.err != nil {
+		return r.err
+	}
+	return ErrNotFinite
+}
+
+func isFinite(f float64) bool {
+	return !math.IsNaN(f) && !math.IsInf(f, 0)
+}
@@ -0,0 +1,61 @@
+package validation
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFinite(t *testing.T) {
+	type celsius float64
+	var f *float64
+	nan := math.NaN()
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", 1.5, ""},
+		{"t2", -1e300, ""},
+		{"t3", math.NaN(), "must be a finite number"},
+		{"t4", math.Inf(1), "must be a finite number"},
+		{"t5", math.Inf(-1), "must be a finite number"},
+		{"t6", float32(math.Inf(1)), "must be a finite number"},
+		{"t7", celsius(math.NaN()), "must be a finite number"},
+		{"t8", &nan, "must be a finite number"},
+		{"t9", complex(1, math.Inf(1)), "must be a finite number"},
+		{"t10", complex(1, 2), ""},
+		{"t11", *new(big.Float).SetInf(false), "must be a finite number"},
+		{"t12", *big.NewFloat(1.5), ""},
+		{"t13", *big.NewInt(1), ""},
+		{"t14", 42, ""},
+		{"t15", uint8(1), ""},
+		{"t16", 0.0, ""},
+		{"t17", f, ""},
+		{"t18", "NaN", "type not supported: string"},
+	}
+
+	for _, test := range tests {
+		err := Finite.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func Test_FiniteRule_Error(t *testing.T) {
+	r := Finite.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+	assert.Equal(t, "validation_not_finite", r.err.Code())
+	assert.Nil(t, Finite.err)
+}
+
+func TestFiniteRule_ErrorObject(t *testing.T) {
+	r := Finite
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
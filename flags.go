@@ -0,0 +1,72 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ErrUnknownFlags is the error that returns when an integer has bits set outside of the allowed mask.
+var ErrUnknownFlags = NewError("validation_unknown_flags", "contains unknown flags")
+
+// FlagsSubsetOfRule is a validation rule that checks if an integer bitmask only has known bits set.
+type FlagsSubsetOfRule struct {
+	mask uint64
+	err  Error
+}
+
+// FlagsSubsetOf returns a validation rule that checks if an integer used as a set of flags, such as permission bits,
+// has no bits set outside of the given mask of all known flags. For example,
+//
+//	const (
+//	    Read Permission = 1 << iota
+//	    Write
+//	    Delete
+//	)
+//	rule := validation.FlagsSubsetOf(int(Read | Write | Delete))
+//
+// Both signed and unsigned integer types are supported, and a negative value is checked by its two's complement bits.
+// The unknown bits are available as the "unknown" parameter of the error.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func FlagsSubsetOf(allMask int) FlagsSubsetOfRule {
+	return FlagsSubsetOfRule{
+		mask: uint64(allMask),
+		err:  ErrUnknownFlags,
+	}
+}
+
+// Error sets the error message for the rule.
+func (r FlagsSubsetOfRule) Error(message string) FlagsSubsetOfRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r FlagsSubsetOfRule) ErrorObject(err Error) FlagsSubsetOfRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r FlagsSubsetOfRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	var bits uint64
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		// keep only the bits of the value's own size so that sign extension does not report unknown flags
+		bits = uint64(rv.Int()) & (1<<(rv.Type().Bits()-1)<<1 - 1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		bits = rv.Uint()
+	default:
+		return fmt.Errorf("type not supported: %v", rv.Type())
+	}
+
+	if unknown := bits &^ r.mask; unknown != 0 {
+		return r.err.SetParams(map[string]interface{}{"unknown": unknown})
+	}
+	return nil
+}
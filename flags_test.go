@@ -0,0 +1,59 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlagsSubsetOf(t *testing.T) {
+	var p *int
+	v := 3
+	tests := []struct {
+		tag   string
+		mask  int
+		value interface{}
+		err   string
+	}{
+		{"t1", 7, 5, ""},
+		{"t2", 7, 8, "contains unknown flags"},
+		{"t3", 7, uint8(7), ""},
+		{"t4", 7, uint(9), "contains unknown flags"},
+		{"t5", 7, 0, ""},
+		{"t6", 7, p, ""},
+		{"t7", 7, &v, ""},
+		{"t8", 7, int8(-1), "contains unknown flags"},
+		{"t9", 0xFF, int8(-1), ""},
+		{"t10", 0xFFFF, int16(-2), ""},
+		{"t11", -1, int64(-1), ""},
+		{"t12", 7, "abc", "type not supported: string"},
+		{"t13", 7, 1.5, "type not supported: float64"},
+	}
+
+	for _, test := range tests {
+		err := FlagsSubsetOf(test.mask).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := FlagsSubsetOf(3).Validate(uint16(13))
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "validation_unknown_flags", err.(Error).Code())
+		assert.Equal(t, uint64(12), err.(Error).Params()["unknown"])
+	}
+}
+
+func Test_FlagsSubsetOfRule_Error(t *testing.T) {
+	r := FlagsSubsetOf(1).Error("unknown flags: {{.unknown}}")
+	assert.Equal(t, "unknown flags: 6", r.Validate(7).Error())
+	assert.Equal(t, "contains unknown flags", FlagsSubsetOf(1).err.Message())
+}
+
+func TestFlagsSubsetOfRule_ErrorObject(t *testing.T) {
+	r := FlagsSubsetOf(1)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
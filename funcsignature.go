@@ -0,0 +1,60 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+)
+
+// ErrFuncSignatureInvalid is the error that returns when a value is not a function of the expected signature.
+var ErrFuncSignatureInvalid = NewError("validation_func_signature_invalid", "must be a function of the expected signature")
+
+// FuncSignatureRule is a validation rule that checks if a value is a function of the expected signature.
+type FuncSignatureRule struct {
+	signature reflect.Type
+	err       Error
+}
+
+// FuncSignature returns a validation rule that checks if a value is a function with the given signature,
+// which is specified as the type of a function. For example,
+//
+//	validation.Field(&c.OnError, validation.Required, validation.FuncSignature(reflect.TypeOf((func(int) error)(nil))))
+//
+// A function of a named type matches if its underlying type is the same as the signature.
+// The expected signature is available as the "signature" parameter of the error.
+// A nil value is considered valid. Use the Required rule to make sure a function is not nil.
+func FuncSignature(signature reflect.Type) FuncSignatureRule {
+	r := FuncSignatureRule{signature: signature, err: ErrFuncSignatureInvalid}
+	if signature != nil {
+		r.err = r.err.SetParams(map[string]interface{}{"signature": signature.String()})
+	}
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r FuncSignatureRule) Error(message string) FuncSignatureRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r FuncSignatureRule) ErrorObject(err Error) FuncSignatureRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r FuncSignatureRule) Validate(value interface{}) error {
+	if r.signature == nil || r.signature.Kind() != reflect.Func {
+		return NewInternalError(errors.New("the signature must be specified as the type of a function"))
+	}
+
+	value, isNil := Indirect(value)
+	if isNil {
+		return nil
+	}
+
+	if t := reflect.TypeOf(value); t.Kind() != reflect.Func || !t.ConvertibleTo(r.signature) {
+		return r.err
+	}
+	return nil
+}
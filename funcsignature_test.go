@@ -0,0 +1,65 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type hookFunc func(int) error
+
+func TestFuncSignature(t *testing.T) {
+	var nilFunc func(int) error
+	var hook hookFunc = func(int) error { return nil }
+	f := func(int) error { return nil }
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", f, ""},
+		{"t2", hook, ""},
+		{"t3", &f, ""},
+		{"t4", nilFunc, ""},
+		{"t5", nil, ""},
+		{"t6", func(string) error { return nil }, "must be a function of the expected signature"},
+		{"t7", func(int) {}, "must be a function of the expected signature"},
+		{"t8", func(...int) error { return nil }, "must be a function of the expected signature"},
+		{"t9", 1, "must be a function of the expected signature"},
+	}
+
+	rule := FuncSignature(reflect.TypeOf((func(int) error)(nil)))
+	for _, test := range tests {
+		err := rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := FuncSignature(reflect.TypeOf(1)).Validate(f)
+	if assert.NotNil(t, err) {
+		_, ok := err.(InternalError)
+		assert.True(t, ok)
+	}
+	err = FuncSignature(nil).Validate(f)
+	assert.Equal(t, NewInternalError(errors.New("the signature must be specified as the type of a function")), err)
+
+	s := struct{ Hook hookFunc }{}
+	err = ValidateStruct(&s, Field(&s.Hook, Required, rule))
+	assert.EqualError(t, err, "Hook: cannot be blank.")
+}
+
+func Test_FuncSignatureRule_Error(t *testing.T) {
+	r := FuncSignature(reflect.TypeOf((func(int) error)(nil))).Error("must be {{.signature}}")
+	assert.Equal(t, "must be func(int) error", r.Validate(1).Error())
+}
+
+func TestFuncSignatureRule_ErrorObject(t *testing.T) {
+	r := FuncSignature(reflect.TypeOf((func())(nil)))
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
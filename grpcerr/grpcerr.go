@@ -0,0 +1,36 @@
+// Package grpcerr converts validation errors into the error details of gRPC.
+// It is a separate module so that the validation package does not depend on the gRPC packages.
+package grpcerr
+
+import (
+	"github.com/aboozaid/validation"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// ToBadRequest converts a validation error into the BadRequest error details of gRPC, so that it can be attached
+// to a status with codes.InvalidArgument. For example,
+//
+//	if err := req.Validate(); err != nil {
+//	    st, _ := status.New(codes.InvalidArgument, "invalid request").WithDetails(grpcerr.ToBadRequest(err))
+//	    return nil, st.Err()
+//	}
+//
+// Each message of an Errors becomes a field violation whose field is the dotted path of the error, as returned
+// by Errors.ToMultiMap (e.g. "address.zip"). The violations are sorted by field. A single validation error
+// becomes a violation with an empty field.
+// Nil is returned if the error is nil or is not a validation error, e.g. an InternalError.
+func ToBadRequest(err error) *errdetails.BadRequest {
+	messages, ok := validation.FieldMessages(err)
+	if !ok {
+		return nil
+	}
+
+	br := &errdetails.BadRequest{}
+	for _, m := range messages {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       m.Field,
+			Description: m.Message,
+		})
+	}
+	return br
+}
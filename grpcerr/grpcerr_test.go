@@ -0,0 +1,40 @@
+package grpcerr
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aboozaid/validation"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func TestToBadRequest(t *testing.T) {
+	err := validation.Errors{
+		"name": validation.ErrRequired,
+		"address": validation.Errors{
+			"zip":    validation.ErrLengthOutOfRange.SetParams(map[string]interface{}{"min": 5, "max": 5}),
+			"street": nil,
+		},
+		"tags": validation.Errors{"1": validation.ErrRequired},
+	}
+	br := ToBadRequest(err)
+	if assert.NotNil(t, br) {
+		assert.Equal(t, []*errdetails.BadRequest_FieldViolation{
+			{Field: "address.zip", Description: "the length must be between 5 and 5"},
+			{Field: "name", Description: "cannot be blank"},
+			{Field: "tags.1", Description: "cannot be blank"},
+		}, br.FieldViolations)
+	}
+
+	br = ToBadRequest(validation.ErrRequired)
+	if assert.NotNil(t, br) {
+		assert.Equal(t, []*errdetails.BadRequest_FieldViolation{
+			{Field: "", Description: "cannot be blank"},
+		}, br.FieldViolations)
+	}
+
+	assert.Nil(t, ToBadRequest(nil))
+	assert.Nil(t, ToBadRequest(errors.New("abc")))
+	assert.Nil(t, ToBadRequest(validation.NewInternalError(errors.New("abc"))))
+}
@@ -0,0 +1,121 @@
+package validation
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"sync"
+)
+
+// The names of the built-in hash algorithms.
+const (
+	// HashMD5 is the MD5 hash algorithm. It should only be used for checksums that are not security sensitive.
+	HashMD5 = "md5"
+	// HashSHA1 is the SHA-1 hash algorithm. It should only be used for checksums that are not security sensitive.
+	HashSHA1 = "sha1"
+	// HashSHA256 is the SHA-256 hash algorithm.
+	HashSHA256 = "sha256"
+	// HashSHA384 is the SHA-384 hash algorithm.
+	HashSHA384 = "sha384"
+	// HashSHA512 is the SHA-512 hash algorithm.
+	HashSHA512 = "sha512"
+)
+
+// ErrHashMismatch is the error that returns when the hash of a value does not match the expected one.
+var ErrHashMismatch = NewError("validation_hash_mismatch", "checksum mismatch")
+
+var (
+	hashAlgorithms = map[string]func() hash.Hash{
+		HashMD5:    md5.New,
+		HashSHA1:   sha1.New,
+		HashSHA256: sha256.New,
+		HashSHA384: sha512.New384,
+		HashSHA512: sha512.New,
+	}
+	hashAlgorithmsMu sync.RWMutex
+)
+
+// RegisterHashAlgorithm registers a hash algorithm so that it can be used with MatchesHash.
+// Registering an existing algorithm replaces it.
+func RegisterHashAlgorithm(name string, f func() hash.Hash) {
+	hashAlgorithmsMu.Lock()
+	defer hashAlgorithmsMu.Unlock()
+	hashAlgorithms[name] = f
+}
+
+// HashRule is a validation rule that checks if the hash of a value matches the expected one.
+type HashRule struct {
+	expected  string
+	algorithm string
+	err       Error
+}
+
+// MatchesHash returns a validation rule that checks if the hash of a string or byte slice computed with the named
+// algorithm matches the expected hex-encoded digest, e.g. to make sure uploaded content matches its declared checksum:
+//
+//	err := validation.Validate(content, validation.MatchesHash(upload.SHA256, validation.HashSHA256))
+//
+// The built-in algorithms are HashMD5, HashSHA1, HashSHA256, HashSHA384 and HashSHA512, and more can be registered
+// with RegisterHashAlgorithm. The expected digest is case-insensitive.
+// If the algorithm is not registered or the expected digest is not valid hex, an InternalError is returned when validating.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func MatchesHash(expected, algorithm string) HashRule {
+	return HashRule{
+		expected:  expected,
+		algorithm: algorithm,
+		err:       ErrHashMismatch.SetParams(map[string]interface{}{"algorithm": algorithm}),
+	}
+}
+
+// Error sets the error message for the rule.
+func (r HashRule) Error(message string) HashRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r HashRule) ErrorObject(err Error) HashRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r HashRule) Validate(value interface{}) error {
+	hashAlgorithmsMu.RLock()
+	f, ok := hashAlgorithms[r.algorithm]
+	hashAlgorithmsMu.RUnlock()
+	if !ok {
+		return NewInternalError(fmt.Errorf("unknown hash algorithm %q", r.algorithm))
+	}
+	expected, err := hex.DecodeString(r.expected)
+	if err != nil {
+		return NewInternalError(fmt.Errorf("invalid expected digest: %w", err))
+	}
+
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	h := f()
+	isString, str, isBytes, bs := StringOrBytes(value)
+	if isString {
+		_, _ = io.WriteString(h, str)
+	} else if isBytes {
+		_, _ = h.Write(bs)
+	} else {
+		return errors.New("must be either a string or byte slice")
+	}
+
+	if !bytes.Equal(h.Sum(nil), expected) {
+		return r.err
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package validation
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchesHash(t *testing.T) {
+	const helloSHA256 = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	var s *string
+	tests := []struct {
+		tag       string
+		expected  string
+		algorithm string
+		value     interface{}
+		err       string
+	}{
+		{"t1", helloSHA256, HashSHA256, "hello", ""},
+		{"t2", helloSHA256, HashSHA256, []byte("hello"), ""},
+		{"t3", "2CF24DBA5FB0A30E26E83B2AC5B9E29E1B161E5C1FA7425E73043362938B9824", HashSHA256, "hello", ""},
+		{"t4", helloSHA256, HashSHA256, "hello!", "checksum mismatch"},
+		{"t5", "5d41402abc4b2a76b9719d911017c592", HashMD5, "hello", ""},
+		{"t6", "5d41402abc4b2a76b9719d911017c592", HashSHA1, "hello", "checksum mismatch"},
+		{"t7", helloSHA256, HashSHA256, "", ""},
+		{"t8", helloSHA256, HashSHA256, s, ""},
+		{"t9", helloSHA256, HashSHA256, 123, "must be either a string or byte slice"},
+		{"t10", helloSHA256, "crc32", "hello", `unknown hash algorithm "crc32"`},
+		{"t11", "xyz", HashSHA256, "hello", "invalid expected digest: encoding/hex: invalid byte: U+0078 'x'"},
+	}
+
+	for _, test := range tests {
+		err := MatchesHash(test.expected, test.algorithm).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestRegisterHashAlgorithm(t *testing.T) {
+	RegisterHashAlgorithm("sha224", sha256.New224)
+	defer func() {
+		hashAlgorithmsMu.Lock()
+		delete(hashAlgorithms, "sha224")
+		hashAlgorithmsMu.Unlock()
+	}()
+
+	err := MatchesHash("ea09ae9cc6768c50fcee903ed054556e5bfc8347907f12598aa24193", "sha224").Validate("hello")
+	assert.Nil(t, err)
+}
+
+func Test_HashRule_Error(t *testing.T) {
+	r := MatchesHash("00", HashSHA256)
+	assert.Equal(t, "checksum mismatch", r.Validate("abc").Error())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestHashRule_ErrorObject(t *testing.T) {
+	r := MatchesHash("00", HashSHA256)
+
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
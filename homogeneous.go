@@ -0,0 +1,71 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+)
+
+// ErrValuesNotHomogeneous is the error that returns when the values of a map are of different types.
+var ErrValuesNotHomogeneous = NewError("validation_values_not_homogeneous", "all values must be of the same type")
+
+// HomogeneousValues is a validation rule that checks if all values of a map are of the same concrete type.
+// It is mostly useful for maps with interface values, such as map[string]interface{} produced by loosely-typed
+// decoders, where a section of a configuration is supposed to be uniform. Nil values are ignored.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+var HomogeneousValues = HomogeneousValuesRule{}
+
+// HomogeneousValuesRule is a validation rule that checks if all values of a map are of the same type.
+type HomogeneousValuesRule struct {
+	err Error
+}
+
+// Error sets the error message for the rule.
+func (r HomogeneousValuesRule) Error(message string) HomogeneousValuesRule {
+	r.err = r.defaultError().SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r HomogeneousValuesRule) ErrorObject(err Error) HomogeneousValuesRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r HomogeneousValuesRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Map {
+		return errors.New("must be a map")
+	}
+
+	var typ reflect.Type
+	iter := v.MapRange()
+	for iter.Next() {
+		ev := iter.Value()
+		if ev.Kind() == reflect.Interface {
+			if ev.IsNil() {
+				continue
+			}
+			ev = ev.Elem()
+		}
+		if typ == nil {
+			typ = ev.Type()
+		} else if ev.Type() != typ {
+			return r.defaultError()
+		}
+	}
+	return nil
+}
+
+// defaultError returns the error set for the rule, or ErrValuesNotHomogeneous.
+func (r HomogeneousValuesRule) defaultError() Error {
+	if r.err != nil {
+		return r.err
+	}
+	return ErrValuesNotHomogeneous
+}
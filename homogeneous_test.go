@@ -0,0 +1,51 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHomogeneousValues(t *testing.T) {
+	var m map[string]interface{}
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", map[string]interface{}{"a": 1, "b": 2}, ""},
+		{"t2", map[string]interface{}{"a": 1, "b": "2"}, "all values must be of the same type"},
+		{"t3", map[string]interface{}{"a": 1, "b": int64(2)}, "all values must be of the same type"},
+		{"t4", map[string]interface{}{"a": "x", "b": nil, "c": "y"}, ""},
+		{"t5", map[string]interface{}{"a": nil}, ""},
+		{"t6", map[string]interface{}{"a": []interface{}{1}, "b": []interface{}{"x"}}, ""},
+		{"t7", map[string]interface{}{"a": map[string]interface{}{}, "b": []interface{}{}}, "all values must be of the same type"},
+		{"t8", map[string]int{"a": 1, "b": 2}, ""},
+		{"t9", &map[string]interface{}{"a": true, "b": 1.5}, "all values must be of the same type"},
+		{"t10", map[string]interface{}{}, ""},
+		{"t11", m, ""},
+		{"t12", []interface{}{1, "a"}, "must be a map"},
+	}
+
+	for _, test := range tests {
+		err := HomogeneousValues.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func Test_HomogeneousValuesRule_Error(t *testing.T) {
+	r := HomogeneousValues.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+	assert.Equal(t, "validation_values_not_homogeneous", r.err.Code())
+	assert.Nil(t, HomogeneousValues.err)
+}
+
+func TestHomogeneousValuesRule_ErrorObject(t *testing.T) {
+	r := HomogeneousValues
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,11 @@
+package validation
+
+import "go/token"
+
+// ErrGoIdentifierInvalid is the error that returns in case of an invalid Go identifier.
+var ErrGoIdentifierInvalid = NewError("validation_go_identifier_invalid", "must be a valid Go identifier")
+
+// GoIdentifier is a validation rule that checks if a string is a valid Go identifier, that is,
+// a letter or underscore followed by letters, digits or underscores, which is not a Go keyword.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+var GoIdentifier = NewStringRuleWithError(token.IsIdentifier, ErrGoIdentifierInvalid)
@@ -0,0 +1,30 @@
+package validation
+
+import (
+	"testing"
+)
+
+func TestGoIdentifier(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", "", ""},
+		{"t2", "name", ""},
+		{"t3", "_name2", ""},
+		{"t4", "Αλφα", ""},
+		{"t5", "int", ""},
+		{"t6", "2name", "must be a valid Go identifier"},
+		{"t7", "my-name", "must be a valid Go identifier"},
+		{"t8", "func", "must be a valid Go identifier"},
+		{"t9", "type", "must be a valid Go identifier"},
+		{"t10", []byte("name"), ""},
+		{"t11", 1, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		err := GoIdentifier.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
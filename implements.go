@@ -0,0 +1,64 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+)
+
+// ErrImplementsInvalid is the error that returns when a value does not implement an interface.
+var ErrImplementsInvalid = NewError("validation_implements_invalid", "must implement {{.interface}}")
+
+// ImplementsRule is a validation rule that checks if a value implements an interface.
+type ImplementsRule struct {
+	iface reflect.Type
+	err   Error
+}
+
+// Implements returns a validation rule that checks if the type of a value implements the given interface.
+// The interface should be specified as a nil pointer to it. For example,
+//
+//	validation.Implements((*io.Reader)(nil))
+//
+// A nil value is considered valid. Use the Required or NotNil rule to make sure a value is not nil.
+func Implements(ifacePtr interface{}) ImplementsRule {
+	r := ImplementsRule{err: ErrImplementsInvalid}
+	if t := reflect.TypeOf(ifacePtr); t != nil && t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Interface {
+		r.iface = t.Elem()
+		r.err = r.err.SetParams(map[string]interface{}{"interface": r.iface.String()})
+	}
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r ImplementsRule) Error(message string) ImplementsRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r ImplementsRule) ErrorObject(err Error) ImplementsRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r ImplementsRule) Validate(value interface{}) error {
+	if r.iface == nil {
+		return NewInternalError(errors.New("the interface must be specified as a pointer to it"))
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return nil
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map, reflect.Func, reflect.Chan:
+		if rv.IsNil() {
+			return nil
+		}
+	}
+
+	if rv.Type().Implements(r.iface) {
+		return nil
+	}
+	return r.err
+}
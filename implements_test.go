@@ -0,0 +1,49 @@
+package validation
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImplements(t *testing.T) {
+	var buf *bytes.Buffer
+	var reader io.Reader
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", nil, ""},
+		{"t2", buf, ""},
+		{"t3", reader, ""},
+		{"t4", strings.NewReader("abc"), ""},
+		{"t5", &bytes.Buffer{}, ""},
+		{"t6", bytes.Buffer{}, "must implement io.Reader"},
+		{"t7", "abc", "must implement io.Reader"},
+		{"t8", 123, "must implement io.Reader"},
+	}
+
+	for _, test := range tests {
+		err := Implements((*io.Reader)(nil)).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	assert.Nil(t, Implements((*fmt.Stringer)(nil)).Validate(Celsius(1)))
+	assert.EqualError(t, Implements((*fmt.Stringer)(nil)).Validate(1.0), "must implement fmt.Stringer")
+	assert.EqualError(t, Implements(io.Reader(nil)).Validate("abc"), "the interface must be specified as a pointer to it")
+	assert.EqualError(t, Implements(&buf).Validate("abc"), "the interface must be specified as a pointer to it")
+}
+
+func TestImplementsRule_Error(t *testing.T) {
+	r := Implements((*io.Reader)(nil)).Error("{{.interface}} expected")
+	assert.EqualError(t, r.Validate(1), "io.Reader expected")
+
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+	assert.Equal(t, err, r.err)
+}
@@ -4,16 +4,14 @@
 
 package validation
 
-import (
-	"reflect"
-)
-
 // ErrInInvalid is the error that returns in case of an invalid value for "in" rule.
 var ErrInInvalid = NewError("validation_in_invalid", "must be a valid value")
 
 // In returns a validation rule that checks if a value can be found in the given list of values.
 // reflect.DeepEqual() will be used to determine if two values are equal.
 // For more details please refer to https://golang.org/pkg/reflect/#DeepEqual
+// Numeric values are compared by value regardless of their types, so a value of a custom numeric type
+// matches a plain number with the same value, and 1 matches 1.0 but not 1.5.
 // An empty value is considered valid. Use the Required rule to make sure a value is not empty.
 func In[T any](values ...T) InRule[T] {
 	return InRule[T]{
@@ -22,9 +20,31 @@ func In[T any](values ...T) InRule[T] {
 	}
 }
 
+// InFunc returns a validation rule that checks if a value can be found in the given list of values
+// using the given function to determine if two values are equal. This is useful for values that cannot be
+// compared with reflect.DeepEqual() in a meaningful way, e.g. when comparing strings case-insensitively
+// or structs by their IDs:
+//
+//	rule := validation.InFunc(func(a, b interface{}) bool {
+//	    return strings.EqualFold(a.(string), b.(string))
+//	}, "red", "green", "blue")
+//
+// The function is called with the value being validated as the first argument and an element of the list
+// as the second one. Note that the value is indirected before being compared, so a pointer is compared by
+// the value it references.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func InFunc[T any](eq func(a, b interface{}) bool, values ...T) InRule[T] {
+	return InRule[T]{
+		elements: values,
+		eq:       eq,
+		err:      ErrInInvalid,
+	}
+}
+
 // InRule is a validation rule that validates if a value can be found in the given list of values.
 type InRule[T any] struct {
 	elements []T
+	eq       func(a, b interface{}) bool
 	err      Error
 }
 
@@ -36,7 +56,7 @@ func (r InRule[T]) Validate(value interface{}) error {
 	}
 
 	for _, e := range r.elements {
-		if reflect.DeepEqual(e, value) {
+		if r.eq != nil && r.eq(value, e) || r.eq == nil && equalValues(e, value) {
 			return nil
 		}
 	}
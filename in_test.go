@@ -5,6 +5,7 @@
 package validation
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -61,6 +62,57 @@ func TestIn_Generics(t *testing.T) {
 	}
 }
 
+type Level int
+
+func TestIn_CustomNumericTypes(t *testing.T) {
+	assert.Nil(t, In(1, 2, 3).Validate(Level(2)))
+	assert.EqualError(t, In(1, 2, 3).Validate(Level(4)), "must be a valid value")
+	assert.Nil(t, In(Level(1), Level(2)).Validate(2))
+	assert.Nil(t, In(-273.15, 0.0).Validate(Celsius(-273.15)))
+	assert.Nil(t, In(1, 2).Validate(1.0))
+	assert.EqualError(t, In(1, 2).Validate(1.5), "must be a valid value")
+	assert.EqualError(t, In(-1).Validate(uint(1)), "must be a valid value")
+	assert.EqualError(t, NotIn(1, 2, 3).Validate(Level(2)), "must not be in list")
+	assert.Nil(t, NotIn(1, 2, 3).Validate(Level(4)))
+}
+
+func TestInFunc(t *testing.T) {
+	type item struct {
+		ID   int
+		Tags []string
+	}
+	byID := func(a, b interface{}) bool {
+		return a.(item).ID == b.(item).ID
+	}
+	items := []item{{ID: 1, Tags: []string{"a"}}, {ID: 2}}
+	foldCase := func(a, b interface{}) bool {
+		return strings.EqualFold(a.(string), b.(string))
+	}
+	s := "RED"
+	var sp *string
+
+	tests := []struct {
+		tag   string
+		rule  Rule
+		value interface{}
+		err   string
+	}{
+		{"t1", InFunc(foldCase, "red", "green"), "Red", ""},
+		{"t2", InFunc(foldCase, "red", "green"), "blue", "must be a valid value"},
+		{"t3", InFunc(foldCase, "red", "green"), &s, ""},
+		{"t4", InFunc(foldCase, "red", "green"), sp, ""},
+		{"t5", InFunc(foldCase, "red", "green"), "", ""},
+		{"t6", InFunc(byID, items...), item{ID: 1}, ""},
+		{"t7", InFunc(byID, items...), item{ID: 3, Tags: []string{"a"}}, "must be a valid value"},
+		{"t8", InFunc[item](byID), item{ID: 1}, "must be a valid value"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
 func Test_InRule_Error(t *testing.T) {
 	r := In(1, 2, 3)
 	val := 4
@@ -0,0 +1,71 @@
+package validation
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// InSetRule is a validation rule that checks if a string can be found in a set of strings.
+type InSetRule struct {
+	set map[string]struct{}
+	err Error
+}
+
+// InSet returns a validation rule that checks if a string can be found in the given set.
+// Unlike In, which scans the list of values, the lookup takes constant time, which makes InSet suitable for
+// large allowlists such as thousands of valid SKUs. The set is not copied, so it must not be modified while
+// it is in use. Use LoadSet to build a set from newline-delimited values.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func InSet(set map[string]struct{}) InSetRule {
+	return InSetRule{
+		set: set,
+		err: ErrInInvalid,
+	}
+}
+
+// LoadSet reads newline-delimited values from the given reader into a set that can be used with InSet.
+// Leading and trailing white space is removed from each line, and blank lines are ignored.
+func LoadSet(r io.Reader) (map[string]struct{}, error) {
+	set := map[string]struct{}{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			set[line] = struct{}{}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
+
+// Error sets the error message for the rule.
+func (r InSetRule) Error(message string) InSetRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r InSetRule) ErrorObject(err Error) InSetRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r InSetRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := r.set[str]; ok {
+		return nil
+	}
+	return r.err
+}
@@ -0,0 +1,72 @@
+package validation
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInSet(t *testing.T) {
+	set := map[string]struct{}{"SKU-1": {}, "SKU-2": {}}
+	var s *string
+	sku := "SKU-2"
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", "SKU-1", ""},
+		{"t2", "SKU-3", "must be a valid value"},
+		{"t3", "sku-1", "must be a valid value"},
+		{"t4", &sku, ""},
+		{"t5", []byte("SKU-1"), ""},
+		{"t6", "", ""},
+		{"t7", s, ""},
+		{"t8", 1, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		err := InSet(set).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	assertError(t, "must be a valid value", InSet(nil).Validate("SKU-1"), "t9")
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, errors.New("read failed")
+}
+
+func TestLoadSet(t *testing.T) {
+	set, err := LoadSet(strings.NewReader("SKU-1\n  SKU-2 \r\n\n\nSKU-1\nSKU-3"))
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]struct{}{"SKU-1": {}, "SKU-2": {}, "SKU-3": {}}, set)
+
+	set, err = LoadSet(strings.NewReader(""))
+	assert.Nil(t, err)
+	assert.Empty(t, set)
+
+	set, err = LoadSet(errReader{})
+	assert.EqualError(t, err, "read failed")
+	assert.Nil(t, set)
+}
+
+func Test_InSetRule_Error(t *testing.T) {
+	r := InSet(nil).Error("123")
+	assert.Equal(t, "123", r.err.Message())
+	assert.Equal(t, "must be a valid value", InSet(nil).err.Message())
+}
+
+func TestInSetRule_ErrorObject(t *testing.T) {
+	r := InSet(nil)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,152 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+)
+
+var (
+	// ErrIntervalInvalid is the error that returns when an interval ends before it starts.
+	ErrIntervalInvalid = NewError("validation_interval_invalid", "interval {{.index}} must not end before it starts")
+	// ErrIntervalsOverlap is the error that returns when two intervals overlap.
+	ErrIntervalsOverlap = NewError("validation_intervals_overlap", "intervals {{.first}} and {{.second}} must not overlap")
+)
+
+// NonOverlappingRule is a validation rule that checks if the intervals in a slice do not overlap.
+type NonOverlappingRule struct {
+	startField, endField string
+	err, invalidErr      Error
+}
+
+type indexedInterval struct {
+	index      int
+	start, end time.Time
+}
+
+// NonOverlapping returns a validation rule that checks if the elements of a slice or array of structs
+// form a set of non-overlapping intervals. The start and end of each interval are read from the struct fields
+// with the given names, which must be of type time.Time or *time.Time. For example,
+//
+//	type Booking struct {
+//	    Start, End time.Time
+//	}
+//	err := validation.Validate(bookings, validation.NonOverlapping("Start", "End"))
+//
+// Intervals that merely touch (one ends when the next starts) are not considered overlapping.
+// If the intervals overlap, the error reports the indices of the first overlapping pair.
+// Nil elements are skipped. An empty value is considered valid.
+func NonOverlapping(startField, endField string) NonOverlappingRule {
+	return NonOverlappingRule{
+		startField: startField,
+		endField:   endField,
+		err:        ErrIntervalsOverlap,
+		invalidErr: ErrIntervalInvalid,
+	}
+}
+
+// Error sets the error message that is used when two intervals overlap.
+func (r NonOverlappingRule) Error(message string) NonOverlappingRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct that is used when two intervals overlap.
+func (r NonOverlappingRule) ErrorObject(err Error) NonOverlappingRule {
+	r.err = err
+	return r
+}
+
+// InvalidError sets the error message that is used when an interval ends before it starts.
+func (r NonOverlappingRule) InvalidError(message string) NonOverlappingRule {
+	r.invalidErr = r.invalidErr.SetMessage(message)
+	return r
+}
+
+// InvalidErrorObject sets the error struct that is used when an interval ends before it starts.
+func (r NonOverlappingRule) InvalidErrorObject(err Error) NonOverlappingRule {
+	r.invalidErr = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r NonOverlappingRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return errors.New("must be a slice or an array")
+	}
+
+	intervals := make([]indexedInterval, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		ev := v.Index(i)
+		for ev.Kind() == reflect.Ptr || ev.Kind() == reflect.Interface {
+			if ev.IsNil() {
+				break
+			}
+			ev = ev.Elem()
+		}
+		if ev.Kind() == reflect.Ptr || ev.Kind() == reflect.Interface {
+			continue
+		}
+		if ev.Kind() != reflect.Struct {
+			return NewInternalError(fmt.Errorf("element #%v must be a struct", i))
+		}
+		start, err := r.timeField(ev, r.startField)
+		if err != nil {
+			return err
+		}
+		end, err := r.timeField(ev, r.endField)
+		if err != nil {
+			return err
+		}
+		if start.IsZero() || end.IsZero() {
+			continue
+		}
+		if end.Before(start) {
+			return r.invalidErr.SetParams(map[string]interface{}{"index": i})
+		}
+		intervals = append(intervals, indexedInterval{index: i, start: start, end: end})
+	}
+
+	sort.SliceStable(intervals, func(i, j int) bool {
+		return intervals[i].start.Before(intervals[j].start)
+	})
+	for i := 1; i < len(intervals); i++ {
+		prev, cur := intervals[i-1], intervals[i]
+		if cur.start.Before(prev.end) {
+			first, second := prev.index, cur.index
+			if first > second {
+				first, second = second, first
+			}
+			return r.err.SetParams(map[string]interface{}{"first": first, "second": second})
+		}
+	}
+
+	return nil
+}
+
+// timeField returns the time.Time value of the named field of the given struct.
+func (r NonOverlappingRule) timeField(sv reflect.Value, name string) (time.Time, error) {
+	fv := sv.FieldByName(name)
+	if !fv.IsValid() || !fv.CanInterface() {
+		return time.Time{}, NewInternalError(fmt.Errorf("field %v cannot be found in %v", name, sv.Type()))
+	}
+	if fv.Kind() == reflect.Ptr {
+		if fv.IsNil() {
+			return time.Time{}, nil
+		}
+		fv = fv.Elem()
+	}
+	t, ok := fv.Interface().(time.Time)
+	if !ok {
+		return time.Time{}, NewInternalError(fmt.Errorf("field %v must be of type time.Time", name))
+	}
+	return t, nil
+}
@@ -0,0 +1,63 @@
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type booking struct {
+	Start time.Time
+	End   *time.Time
+	name  string
+}
+
+func TestNonOverlapping(t *testing.T) {
+	at := func(hour int) time.Time {
+		return time.Date(2024, 1, 1, hour, 0, 0, 0, time.UTC)
+	}
+	book := func(start, end int) booking {
+		e := at(end)
+		return booking{Start: at(start), End: &e}
+	}
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", nil, ""},
+		{"t2", []booking{}, ""},
+		{"t3", []booking{book(9, 10), book(10, 11), book(12, 13)}, ""},
+		{"t4", []booking{book(12, 14), book(9, 10), book(13, 15)}, "intervals 0 and 2 must not overlap"},
+		{"t5", []*booking{nil, {Start: at(9)}, {Start: at(8)}}, ""},
+		{"t6", [2]booking{book(9, 11), book(10, 12)}, "intervals 0 and 1 must not overlap"},
+		{"t7", []booking{book(9, 10), book(11, 10)}, "interval 1 must not end before it starts"},
+		{"t8", []interface{}{book(9, 11), &booking{Start: at(8), End: &[]time.Time{at(10)}[0]}}, "intervals 0 and 1 must not overlap"},
+		{"t9", "abc", "must be a slice or an array"},
+		{"t10", []int{1}, "element #0 must be a struct"},
+	}
+
+	for _, test := range tests {
+		err := Validate(test.value, NonOverlapping("Start", "End"))
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := Validate([]booking{book(1, 2)}, NonOverlapping("Start", "Finish"))
+	assert.EqualError(t, err, "field Finish cannot be found in validation.booking")
+	err = Validate([]booking{book(1, 2)}, NonOverlapping("Start", "name"))
+	assert.EqualError(t, err, "field name cannot be found in validation.booking")
+}
+
+func TestNonOverlappingRule_Error(t *testing.T) {
+	r := NonOverlapping("Start", "End").Error("{{.first}} overlaps {{.second}}").InvalidError("bad {{.index}}")
+	e := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+	bookings := []booking{{Start: e.Add(-time.Hour), End: &e}, {Start: e.Add(-time.Minute), End: &e}}
+	assert.EqualError(t, r.Validate(bookings), "0 overlaps 1")
+	assert.EqualError(t, r.Validate([]booking{{Start: e.Add(time.Hour), End: &e}}), "bad 0")
+
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err).InvalidErrorObject(err)
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err, r.invalidErr)
+}
@@ -0,0 +1,48 @@
+package is
+
+import (
+	"unicode/utf8"
+
+	"github.com/aboozaid/validation"
+)
+
+// ASCIIRule is a validation rule that checks if a string contains ASCII characters only.
+type ASCIIRule struct {
+	printable bool
+	err       validation.Error
+}
+
+// Error sets the error message for the rule.
+// The zero-based rune position of the first offending character is available as the "position" parameter.
+func (r ASCIIRule) Error(message string) ASCIIRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r ASCIIRule) ErrorObject(err validation.Error) ASCIIRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r ASCIIRule) Validate(value interface{}) error {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return nil
+	}
+
+	str, err := validation.EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	position := 0
+	for _, c := range str {
+		if c >= utf8.RuneSelf || r.printable && (c < 0x20 || c == 0x7F) {
+			return r.err.SetParams(map[string]interface{}{"position": position})
+		}
+		position++
+	}
+	return nil
+}
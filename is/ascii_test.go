@@ -0,0 +1,51 @@
+package is
+
+import (
+	"testing"
+
+	"github.com/aboozaid/validation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestASCIIRule_Position(t *testing.T) {
+	tests := []struct {
+		tag      string
+		rule     ASCIIRule
+		value    string
+		position int
+	}{
+		{"t1", ASCII, "ａabc", 0},
+		{"t2", ASCII, "abcé", 3},
+		{"t3", ASCII, "日本x語", 0},
+		{"t4", ASCII, "ab\xff", 2},
+		{"t5", PrintableASCII, "abc\n", 3},
+		{"t6", PrintableASCII, "aé\n", 1},
+		{"t7", PrintableASCII, "éé\x7f", 0},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		if e, ok := err.(validation.Error); assert.True(t, ok, test.tag) {
+			assert.Equal(t, test.position, e.Params()["position"], test.tag)
+		}
+	}
+}
+
+func TestASCIIRule_NotString(t *testing.T) {
+	err := ASCII.Validate(123)
+	assertError(t, "must be either a string or byte slice", err, "t1")
+	assert.Nil(t, PrintableASCII.Validate([]byte("abc")))
+}
+
+func TestASCIIRule_Error(t *testing.T) {
+	r := ASCII.Error("invalid character at {{.position}}")
+	assert.Equal(t, "must contain ASCII characters only", ASCII.err.Message())
+	assertError(t, "invalid character at 2", r.Validate("abé"), "t1")
+}
+
+func TestASCIIRule_ErrorObject(t *testing.T) {
+	err := validation.NewError("code", "abc")
+	r := PrintableASCII.ErrorObject(err)
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, "must contain printable ASCII characters only", PrintableASCII.err.Message())
+}
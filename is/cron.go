@@ -0,0 +1,95 @@
+package is
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/aboozaid/validation"
+)
+
+// ErrCron is the error that returns in case of an invalid cron expression.
+var ErrCron = validation.NewError("validation_is_cron", "must be a valid cron expression")
+
+// Cron validates if a string is a valid cron expression with 5 fields (minute, hour, day of month, month and
+// day of week), or 6 fields with a leading seconds field, e.g. "*/15 9-17 * * MON-FRI" or "0 30 2 1,15 * *".
+// Each field is "*", a number, or a range such as "1-5", optionally followed by a step such as "/10", and several of
+// them may be separated by commas. Months and days of week may also be given by their three-letter English names,
+// and both 0 and 7 mean Sunday.
+var Cron = validation.NewStringRuleWithError(isCron, ErrCron)
+
+// cronField describes the allowed values of a field of a cron expression.
+type cronField struct {
+	min, max int
+	names    []string
+}
+
+var (
+	cronSeconds = cronField{min: 0, max: 59}
+	cronFields  = []cronField{
+		{min: 0, max: 59},
+		{min: 0, max: 23},
+		{min: 1, max: 31},
+		{min: 1, max: 12, names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+		{min: 0, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+	}
+)
+
+func isCron(value string) bool {
+	fields := strings.Fields(value)
+	switch len(fields) {
+	case len(cronFields) + 1:
+		if !cronSeconds.valid(fields[0]) {
+			return false
+		}
+		fields = fields[1:]
+	case len(cronFields):
+	default:
+		return false
+	}
+	for i, f := range fields {
+		if !cronFields[i].valid(f) {
+			return false
+		}
+	}
+	return true
+}
+
+// valid checks if the given value is a valid comma-separated list of values, ranges or steps for the field.
+func (f cronField) valid(value string) bool {
+	for _, item := range strings.Split(value, ",") {
+		base, step, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			if n, err := strconv.Atoi(step); err != nil || n < 1 || n > f.max {
+				return false
+			}
+		}
+		if base == "*" {
+			continue
+		}
+		from, to, isRange := strings.Cut(base, "-")
+		lo, ok := f.value(from)
+		if !ok {
+			return false
+		}
+		if isRange {
+			if hi, ok := f.value(to); !ok || hi < lo {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// value returns the number represented by a number or name in the field.
+func (f cronField) value(s string) (int, bool) {
+	for i, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return f.min + i, true
+		}
+	}
+	if s == "" || s[0] == '+' || s[0] == '-' {
+		return 0, false
+	}
+	n, err := strconv.Atoi(s)
+	return n, err == nil && n >= f.min && n <= f.max
+}
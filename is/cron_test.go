@@ -0,0 +1,48 @@
+package is
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCron(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value string
+		valid bool
+	}{
+		{"t1", "* * * * *", true},
+		{"t2", "*/15 9-17 * * MON-FRI", true},
+		{"t3", "0 30 2 1,15 * *", true},
+		{"t4", "0 0 1 jan,jul sun", true},
+		{"t5", "5 0 * 8 7", true},
+		{"t6", "0-59/5 0 1-31 1-12 0-6", true},
+		{"t7", "10/5 * * * *", true},
+		{"t8", "  0 12  * * 1  ", true},
+		{"t9", "* * * *", false},
+		{"t10", "* * * * * * *", false},
+		{"t11", "60 * * * *", false},
+		{"t12", "* 24 * * *", false},
+		{"t13", "* * 0 * *", false},
+		{"t14", "* * * 13 *", false},
+		{"t15", "* * * * 8", false},
+		{"t16", "5-1 * * * *", false},
+		{"t17", "*/0 * * * *", false},
+		{"t18", "*/ * * * *", false},
+		{"t19", "1,,2 * * * *", false},
+		{"t20", "? * * * *", false},
+		{"t21", "* * * * MONDAY", false},
+		{"t22", "* * * JAN-MON *", false},
+		{"t23", "60 * * * * *", false},
+		{"t24", "+5 * * * *", false},
+		{"t25", "@daily", false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.valid, isCron(test.value), test.tag)
+	}
+
+	assert.Nil(t, Cron.Validate(""))
+	assertError(t, "must be a valid cron expression", Cron.Validate("* * *"), "t26")
+}
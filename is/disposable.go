@@ -0,0 +1,67 @@
+package is
+
+import (
+	_ "embed"
+	"strings"
+	"sync"
+
+	"github.com/aboozaid/validation"
+	"github.com/asaskevich/govalidator"
+)
+
+// ErrEmailDisposable is the error that returns when an email belongs to a known disposable email domain.
+var ErrEmailDisposable = validation.NewError("validation_is_email_disposable", "disposable email addresses are not allowed")
+
+// NonDisposableEmail validates if a string is an email whose domain is not a known disposable email domain.
+// The subdomains of a disposable domain are considered disposable as well. Like EmailFormat, it does NOT check
+// if the MX record exists. The built-in list of disposable domains can be replaced by calling SetDisposableDomains.
+var NonDisposableEmail = EmailRule{
+	validate:        govalidator.IsEmail,
+	checkDisposable: true,
+	err:             ErrEmail,
+	notAllowedErr:   ErrEmailDomainNotAllowed,
+	blockedErr:      ErrEmailDisposable,
+}
+
+//go:embed disposable_domains.txt
+var builtinDisposableDomains string
+
+var (
+	disposableDomains   = newDomainSet(strings.Fields(builtinDisposableDomains))
+	disposableDomainsMu sync.RWMutex
+)
+
+// SetDisposableDomains replaces the list of disposable email domains used by NonDisposableEmail.
+// The domains are compared case-insensitively.
+func SetDisposableDomains(domains []string) {
+	set := newDomainSet(domains)
+	disposableDomainsMu.Lock()
+	defer disposableDomainsMu.Unlock()
+	disposableDomains = set
+}
+
+// isDisposableDomain checks if the domain or one of its parent domains is a disposable email domain.
+func isDisposableDomain(domain string) bool {
+	disposableDomainsMu.RLock()
+	defer disposableDomainsMu.RUnlock()
+	for {
+		if _, ok := disposableDomains[domain]; ok {
+			return true
+		}
+		i := strings.IndexByte(domain, '.')
+		if i < 0 {
+			return false
+		}
+		domain = domain[i+1:]
+	}
+}
+
+func newDomainSet(domains []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(domains))
+	for _, d := range domains {
+		if d = strings.ToLower(strings.TrimSpace(d)); d != "" {
+			set[d] = struct{}{}
+		}
+	}
+	return set
+}
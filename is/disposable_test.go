@@ -0,0 +1,45 @@
+package is
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aboozaid/validation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNonDisposableEmail(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value string
+		err   string
+	}{
+		{"t1", "john@example.com", ""},
+		{"t2", "john@mailinator.com", "disposable email addresses are not allowed"},
+		{"t3", "john@YOPMAIL.com", "disposable email addresses are not allowed"},
+		{"t4", "john@eu.mailinator.com", "disposable email addresses are not allowed"},
+		{"t5", "john@notmailinator.com", ""},
+		{"t6", "mailinator.com", "must be a valid email address"},
+		{"t7", "", ""},
+	}
+
+	for _, test := range tests {
+		err := NonDisposableEmail.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := NonDisposableEmail.Validate("john@mailinator.com")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "validation_is_email_disposable", err.(validation.Error).Code())
+		assert.Equal(t, "mailinator.com", err.(validation.Error).Params()["domain"])
+	}
+	assert.Nil(t, EmailFormat.Validate("john@mailinator.com"))
+}
+
+func TestSetDisposableDomains(t *testing.T) {
+	defer SetDisposableDomains(strings.Fields(builtinDisposableDomains))
+	SetDisposableDomains([]string{"Example.ORG", " "})
+	assert.Nil(t, NonDisposableEmail.Validate("john@mailinator.com"))
+	assertError(t, "disposable email addresses are not allowed", NonDisposableEmail.Validate("john@example.org"), "t1")
+	assertError(t, "disposable email addresses are not allowed", NonDisposableEmail.Validate("john@mail.example.org"), "t2")
+}
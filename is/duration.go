@@ -0,0 +1,56 @@
+package is
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ISO 8601 duration regex, e.g. P1Y2M10DT2H30M, P3W, PT0.5S
+var reISODuration = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:[.,]\d+)?)S)?)?$`)
+
+// Duration represents the components of an ISO 8601 duration.
+type Duration struct {
+	Years, Months, Weeks, Days, Hours, Minutes int
+	Seconds                                    float64
+}
+
+// ParseISODuration parses an ISO 8601 duration such as P1Y2M10DT2H30M.
+// Only the seconds component may have a fraction. At least one component must be specified.
+func ParseISODuration(value string) (Duration, error) {
+	m := reISODuration.FindStringSubmatch(value)
+	if m == nil || value == "P" || strings.HasSuffix(value, "T") {
+		return Duration{}, errors.New("invalid ISO 8601 duration")
+	}
+
+	var d Duration
+	for i, p := range []*int{&d.Years, &d.Months, &d.Weeks, &d.Days, &d.Hours, &d.Minutes} {
+		if m[i+1] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(m[i+1])
+		if err != nil {
+			return Duration{}, err
+		}
+		*p = n
+	}
+	if m[7] != "" {
+		s, err := strconv.ParseFloat(strings.Replace(m[7], ",", ".", 1), 64)
+		if err != nil {
+			return Duration{}, err
+		}
+		d.Seconds = s
+	}
+	return d, nil
+}
+
+// AddTo adds the duration to the given time. Years, months and days are added
+// using calendar arithmetic as done by time.Time.AddDate.
+func (d Duration) AddTo(t time.Time) time.Time {
+	t = t.AddDate(d.Years, d.Months, d.Weeks*7+d.Days)
+	return t.Add(time.Duration(d.Hours)*time.Hour +
+		time.Duration(d.Minutes)*time.Minute +
+		time.Duration(d.Seconds*float64(time.Second)))
+}
@@ -0,0 +1,42 @@
+package is
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseISODuration(t *testing.T) {
+	tests := []struct {
+		tag      string
+		value    string
+		duration Duration
+		err      string
+	}{
+		{"t1", "P1Y2M10DT2H30M", Duration{Years: 1, Months: 2, Days: 10, Hours: 2, Minutes: 30}, ""},
+		{"t2", "P3W", Duration{Weeks: 3}, ""},
+		{"t3", "PT0.5S", Duration{Seconds: 0.5}, ""},
+		{"t4", "PT1,5S", Duration{Seconds: 1.5}, ""},
+		{"t5", "P1M", Duration{Months: 1}, ""},
+		{"t6", "PT1M", Duration{Minutes: 1}, ""},
+		{"t7", "P", Duration{}, "invalid ISO 8601 duration"},
+		{"t8", "P1DT", Duration{}, "invalid ISO 8601 duration"},
+		{"t9", "1D", Duration{}, "invalid ISO 8601 duration"},
+		{"t10", "P1H", Duration{}, "invalid ISO 8601 duration"},
+		{"t11", "P1.5D", Duration{}, "invalid ISO 8601 duration"},
+		{"t12", "PT", Duration{}, "invalid ISO 8601 duration"},
+	}
+
+	for _, test := range tests {
+		d, err := ParseISODuration(test.value)
+		assert.Equal(t, test.duration, d, test.tag)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestDuration_AddTo(t *testing.T) {
+	d, _ := ParseISODuration("P1Y2M1W3DT2H30M1.5S")
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2021, 3, 11, 2, 30, 1, 500000000, time.UTC), d.AddTo(start))
+}
@@ -0,0 +1,147 @@
+package is
+
+import (
+	"strings"
+
+	"github.com/aboozaid/validation"
+	"github.com/asaskevich/govalidator"
+)
+
+var (
+	// ErrEmailDomainNotAllowed is the error that returns when the domain of an email is not in the allowed list.
+	ErrEmailDomainNotAllowed = validation.NewError("validation_is_email_domain_not_allowed", "email domain not allowed")
+	// ErrEmailDomainBlocked is the error that returns when the domain of an email is in the blocked list.
+	ErrEmailDomainBlocked = validation.NewError("validation_is_email_domain_blocked", "disposable email addresses are not permitted")
+
+	// EmailAddress validates if a string is an email and can be restricted to allowed domains or reject blocked
+	// domains by calling AllowDomains and BlockDomains, e.g. EmailAddress.AllowDomains("example.com").
+	// Like EmailFormat, it does NOT check if the MX record exists unless CheckMX is called.
+	EmailAddress = EmailRule{
+		validate:      govalidator.IsEmail,
+		err:           ErrEmail,
+		notAllowedErr: ErrEmailDomainNotAllowed,
+		blockedErr:    ErrEmailDomainBlocked,
+	}
+)
+
+// EmailRule is a validation rule that checks if a string is a valid email address
+// and optionally checks its domain against lists of allowed and blocked domains.
+type EmailRule struct {
+	validate         func(string) bool
+	allowed, blocked []string
+	subdomains       bool
+	checkDisposable  bool
+	err              validation.Error
+	notAllowedErr    validation.Error
+	blockedErr       validation.Error
+}
+
+// CheckMX makes the rule check if the MX record exists for the email domain, like Email does.
+func (r EmailRule) CheckMX() EmailRule {
+	r.validate = govalidator.IsExistingEmail
+	return r
+}
+
+// AllowDomains restricts the domain of an email to the given list. The domains are compared case-insensitively.
+// The domain of the email is available as the "domain" parameter of the error.
+func (r EmailRule) AllowDomains(domains ...string) EmailRule {
+	r.allowed = appendDomains(r.allowed, domains)
+	return r
+}
+
+// BlockDomains rejects emails whose domain is in the given list. The domains are compared case-insensitively.
+// The domain of the email is available as the "domain" parameter of the error.
+func (r EmailRule) BlockDomains(domains ...string) EmailRule {
+	r.blocked = appendDomains(r.blocked, domains)
+	return r
+}
+
+// IncludeSubdomains makes the allowed and blocked domains match their subdomains as well.
+// For example, "example.com" will then also match "mail.example.com".
+func (r EmailRule) IncludeSubdomains() EmailRule {
+	r.subdomains = true
+	return r
+}
+
+// Error sets the error message that is used when the value being validated is not a valid email address.
+func (r EmailRule) Error(message string) EmailRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct that is used when the value being validated is not a valid email address.
+func (r EmailRule) ErrorObject(err validation.Error) EmailRule {
+	r.err = err
+	return r
+}
+
+// NotAllowedError sets the error message that is used when the email domain is not in the allowed list.
+func (r EmailRule) NotAllowedError(message string) EmailRule {
+	r.notAllowedErr = r.notAllowedErr.SetMessage(message)
+	return r
+}
+
+// NotAllowedErrorObject sets the error struct that is used when the email domain is not in the allowed list.
+func (r EmailRule) NotAllowedErrorObject(err validation.Error) EmailRule {
+	r.notAllowedErr = err
+	return r
+}
+
+// BlockedError sets the error message that is used when the email domain is in the blocked list.
+func (r EmailRule) BlockedError(message string) EmailRule {
+	r.blockedErr = r.blockedErr.SetMessage(message)
+	return r
+}
+
+// BlockedErrorObject sets the error struct that is used when the email domain is in the blocked list.
+func (r EmailRule) BlockedErrorObject(err validation.Error) EmailRule {
+	r.blockedErr = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r EmailRule) Validate(value interface{}) error {
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return nil
+	}
+
+	str, err := validation.EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	if !r.validate(str) {
+		return r.err
+	}
+
+	domain := strings.ToLower(str[strings.LastIndex(str, "@")+1:])
+	if len(r.blocked) > 0 && r.matchDomain(domain, r.blocked) || r.checkDisposable && isDisposableDomain(domain) {
+		return r.blockedErr.SetParams(map[string]interface{}{"domain": domain})
+	}
+	if len(r.allowed) > 0 && !r.matchDomain(domain, r.allowed) {
+		return r.notAllowedErr.SetParams(map[string]interface{}{"domain": domain})
+	}
+
+	return nil
+}
+
+// matchDomain checks if the domain is one of the given domains, or a subdomain of one if enabled.
+func (r EmailRule) matchDomain(domain string, domains []string) bool {
+	for _, d := range domains {
+		if domain == d || r.subdomains && strings.HasSuffix(domain, "."+d) {
+			return true
+		}
+	}
+	return false
+}
+
+// appendDomains appends the normalized domains to a copy of the list.
+func appendDomains(list, domains []string) []string {
+	result := make([]string, len(list), len(list)+len(domains))
+	copy(result, list)
+	for _, d := range domains {
+		result = append(result, strings.ToLower(strings.TrimSpace(d)))
+	}
+	return result
+}
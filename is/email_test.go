@@ -0,0 +1,82 @@
+package is
+
+import (
+	"testing"
+
+	"github.com/aboozaid/validation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmailRule_Domains(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  EmailRule
+		value string
+		err   string
+	}{
+		{"t1", EmailAddress.AllowDomains("example.com"), "john@example.com", ""},
+		{"t2", EmailAddress.AllowDomains("example.com"), "john@EXAMPLE.com", ""},
+		{"t3", EmailAddress.AllowDomains("Example.com"), "john@example.com", ""},
+		{"t4", EmailAddress.AllowDomains("example.com"), "john@example.org", "email domain not allowed"},
+		{"t5", EmailAddress.AllowDomains("example.com"), "john@mail.example.com", "email domain not allowed"},
+		{"t6", EmailAddress.AllowDomains("example.com").IncludeSubdomains(), "john@mail.example.com", ""},
+		{"t7", EmailAddress.AllowDomains("example.com").IncludeSubdomains(), "john@badexample.com", "email domain not allowed"},
+		{"t8", EmailAddress.AllowDomains("example.com", "example.org"), "john@example.org", ""},
+		{"t9", EmailAddress.BlockDomains("mailinator.com"), "john@mailinator.com", "disposable email addresses are not permitted"},
+		{"t10", EmailAddress.BlockDomains("mailinator.com"), "john@example.com", ""},
+		{"t11", EmailAddress.BlockDomains("mailinator.com"), "john@eu.mailinator.com", ""},
+		{"t12", EmailAddress.BlockDomains("mailinator.com").IncludeSubdomains(), "john@eu.mailinator.com", "disposable email addresses are not permitted"},
+		{"t13", EmailAddress.AllowDomains("mailinator.com").BlockDomains("mailinator.com"), "john@mailinator.com", "disposable email addresses are not permitted"},
+		{"t14", EmailAddress.AllowDomains("example.com"), "example.com", "must be a valid email address"},
+		{"t15", EmailAddress.AllowDomains("example.com"), "", ""},
+		{"t16", EmailAddress.BlockDomains("mailinator.com").CheckMX(), "example.com", "must be a valid email address"},
+		{"t17", EmailAddress, "john@example.org", ""},
+		{"t18", EmailAddress, "example.org", "must be a valid email address"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestEmail_StringRule(t *testing.T) {
+	// the domain options are provided by separate rules so that Email and EmailFormat remain string rules
+	assert.IsType(t, validation.StringRule{}, Email)
+	assert.IsType(t, validation.StringRule{}, EmailFormat)
+}
+
+func TestEmailRule_DomainParam(t *testing.T) {
+	err := EmailAddress.BlockDomains("mailinator.com").Validate("john@Mailinator.com")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "validation_is_email_domain_blocked", err.(validation.Error).Code())
+		assert.Equal(t, "mailinator.com", err.(validation.Error).Params()["domain"])
+	}
+}
+
+func TestEmailRule_Copy(t *testing.T) {
+	base := EmailAddress.AllowDomains("example.com")
+	r1 := base.AllowDomains("example.org")
+	r2 := base.AllowDomains("example.net")
+	assert.Nil(t, r1.Validate("john@example.org"))
+	assertError(t, "email domain not allowed", r2.Validate("john@example.org"), "t1")
+	assert.Equal(t, []string{"example.com"}, base.allowed)
+	assert.Empty(t, EmailAddress.allowed)
+}
+
+func TestEmailRule_Error(t *testing.T) {
+	r := EmailAddress.AllowDomains("example.com").BlockDomains("mailinator.com").
+		Error("abc").NotAllowedError("{{.domain}} is not allowed").BlockedError("{{.domain}} is blocked")
+	assertError(t, "abc", r.Validate("john"), "t1")
+	assertError(t, "example.org is not allowed", r.Validate("john@example.org"), "t2")
+	assertError(t, "mailinator.com is blocked", r.Validate("john@mailinator.com"), "t3")
+	assert.Equal(t, "must be a valid email address", ErrEmail.Message())
+}
+
+func TestEmailRule_ErrorObject(t *testing.T) {
+	err := validation.NewError("code", "abc")
+	r := EmailAddress.AllowDomains("example.com").ErrorObject(err).NotAllowedErrorObject(err).BlockedErrorObject(err)
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err, r.notAllowedErr)
+	assert.Equal(t, err, r.blockedErr)
+}
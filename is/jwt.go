@@ -0,0 +1,36 @@
+package is
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/aboozaid/validation"
+)
+
+// ErrJWT is the error that returns in case of a malformed JWT.
+var ErrJWT = validation.NewError("validation_is_jwt", "must be a well-formed JWT")
+
+// JWT validates if a string is a well-formed JSON Web Token in the compact serialization, i.e. three base64url-encoded
+// parts separated by dots, where the header and the payload are JSON objects. The signature may be empty, as is the
+// case for unsecured tokens. Note that the signature is NOT verified, so this only serves to reject malformed tokens early.
+var JWT = validation.NewStringRuleWithError(isJWT, ErrJWT)
+
+func isJWT(value string) bool {
+	parts := strings.Split(value, ".")
+	if len(parts) != 3 {
+		return false
+	}
+	for _, part := range parts[:2] {
+		data, err := base64.RawURLEncoding.Strict().DecodeString(part)
+		if err != nil {
+			return false
+		}
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(data, &object); err != nil || object == nil {
+			return false
+		}
+	}
+	_, err := base64.RawURLEncoding.Strict().DecodeString(parts[2])
+	return err == nil
+}
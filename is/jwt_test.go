@@ -0,0 +1,39 @@
+package is
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJWT(t *testing.T) {
+	const (
+		header  = "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9"
+		payload = "eyJzdWIiOiIxMjM0NTY3ODkwIiwibmFtZSI6IkpvaG4gRG9lIiwiaWF0IjoxNTE2MjM5MDIyfQ"
+		sig     = "SflKxwRJSMeKKF2QT4fwpMeJf36POk6yJV_adQssw5c"
+	)
+	tests := []struct {
+		tag   string
+		value string
+		valid bool
+	}{
+		{"t1", header + "." + payload + "." + sig, true},
+		{"t2", header + "." + payload + ".", true},
+		{"t3", header + "." + payload, false},
+		{"t4", header + "." + payload + "." + sig + ".x", false},
+		{"t5", header + "=." + payload + "." + sig, false},
+		{"t6", "bnVsbA." + payload + "." + sig, false},
+		{"t7", header + ".WzFd." + sig, false},
+		{"t8", header + ".eyJhIjox." + sig, false},
+		{"t9", header + "." + payload + ".abc+/", false},
+		{"t10", "." + payload + "." + sig, false},
+		{"t11", "abc", false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.valid, isJWT(test.value), test.tag)
+	}
+
+	assert.Nil(t, JWT.Validate(""))
+	assertError(t, "must be a well-formed JWT", JWT.Validate("a.b.c"), "t12")
+}
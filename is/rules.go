@@ -7,10 +7,13 @@ package is
 
 import (
 	"regexp"
+	"strings"
+	"time"
 	"unicode"
 
 	"github.com/aboozaid/validation"
 	"github.com/asaskevich/govalidator"
+	"golang.org/x/text/language"
 )
 
 var (
@@ -42,6 +45,10 @@ var (
 	ErrUpperCase = validation.NewError("validation_is_upper_case", "must be in upper case")
 	// ErrHexadecimal is the error that returns in case of an invalid hexadecimal number.
 	ErrHexadecimal = validation.NewError("validation_is_hexadecimal", "must be a valid hexadecimal number")
+	// ErrOctal is the error that returns in case of an invalid octal number.
+	ErrOctal = validation.NewError("validation_is_octal", "must be a valid octal number")
+	// ErrBinary is the error that returns in case of an invalid binary number.
+	ErrBinary = validation.NewError("validation_is_binary", "must be a valid binary number")
 	// ErrHexColor is the error that returns in case of an invalid hexadecimal color code.
 	ErrHexColor = validation.NewError("validation_is_hex_color", "must be a valid hexadecimal color code")
 	// ErrRGBColor is the error that returns in case of an invalid RGB color code.
@@ -58,6 +65,8 @@ var (
 	ErrUUIDv5 = validation.NewError("validation_is_uuid_v5", "must be a valid UUID v5")
 	// ErrUUID is the error that returns in case of an invalid UUID value.
 	ErrUUID = validation.NewError("validation_is_uuid", "must be a valid UUID")
+	// ErrNonZeroUUID is the error that returns in case of the nil UUID.
+	ErrNonZeroUUID = validation.NewError("validation_is_non_zero_uuid", "must not be the nil UUID")
 	// ErrULID is the error that returns in case of an invalid ULID value.
 	ErrULID = validation.NewError("validation_is_ulid", "must be a valid ULID")
 	// ErrCreditCard is the error that returns in case of an invalid credit card number.
@@ -68,6 +77,10 @@ var (
 	ErrISBN13 = validation.NewError("validation_is_isbn_13", "must be a valid ISBN-13")
 	// ErrISBN is the error that returns in case of an invalid ISBN value.
 	ErrISBN = validation.NewError("validation_is_isbn", "must be a valid ISBN")
+	// ErrEAN13 is the error that returns in case of an invalid EAN-13 value.
+	ErrEAN13 = validation.NewError("validation_is_ean13", "must be a valid EAN-13")
+	// ErrUPCA is the error that returns in case of an invalid UPC-A value.
+	ErrUPCA = validation.NewError("validation_is_upca", "must be a valid UPC-A")
 	// ErrJSON is the error that returns in case of an invalid JSON.
 	ErrJSON = validation.NewError("validation_is_json", "must be in valid JSON format")
 	// ErrASCII is the error that returns in case of an invalid ASCII.
@@ -121,9 +134,19 @@ var (
 	// ErrLongitude is the error that returns in case of an invalid longitude.
 	ErrLongitude = validation.NewError("validation_is_longitude", "must be a valid longitude")
 	// ErrSSN is the error that returns in case of an invalid SSN.
-	ErrSSN = validation.NewError("validation_is_ssn", "must be a valid social security number")
+	ErrSSN = validation.NewError("validation_is_ssn", "must be a valid Social Security Number")
+	// ErrTimeZone is the error that returns in case of an invalid time zone name.
+	ErrTimeZone = validation.NewError("validation_is_time_zone", "must be a valid IANA time zone")
+	// ErrLanguageTag is the error that returns in case of an invalid BCP 47 language tag.
+	ErrLanguageTag = validation.NewError("validation_is_language_tag", "must be a valid language tag")
+	// ErrEIN is the error that returns in case of an invalid EIN.
+	ErrEIN = validation.NewError("validation_is_ein", "must be a valid EIN")
 	// ErrSemver is the error that returns in case of an invalid semver.
 	ErrSemver = validation.NewError("validation_is_semver", "must be a valid semantic version")
+	// ErrISODuration is the error that returns in case of an invalid ISO 8601 duration.
+	ErrISODuration = validation.NewError("validation_is_iso_duration", "must be a valid ISO 8601 duration")
+	// ErrRFC3339 is the error that returns in case of an invalid RFC 3339 timestamp.
+	ErrRFC3339 = validation.NewError("validation_is_rfc3339", "must be an RFC 3339 timestamp")
 )
 
 var (
@@ -155,8 +178,12 @@ var (
 	LowerCase = validation.NewStringRuleWithError(govalidator.IsLowerCase, ErrLowerCase)
 	// UpperCase validates if a string contains upper case unicode letters only
 	UpperCase = validation.NewStringRuleWithError(govalidator.IsUpperCase, ErrUpperCase)
-	// Hexadecimal validates if a string is a valid hexadecimal number
-	Hexadecimal = validation.NewStringRuleWithError(govalidator.IsHexadecimal, ErrHexadecimal)
+	// Hexadecimal validates if a string is a valid hexadecimal number, optionally prefixed with "0x"
+	Hexadecimal = validation.NewStringRuleWithError(reHexadecimal.MatchString, ErrHexadecimal)
+	// Octal validates if a string is a valid octal number, optionally prefixed with "0o"
+	Octal = validation.NewStringRuleWithError(reOctal.MatchString, ErrOctal)
+	// Binary validates if a string is a valid binary number, optionally prefixed with "0b"
+	Binary = validation.NewStringRuleWithError(reBinary.MatchString, ErrBinary)
 	// HexColor validates if a string is a valid hexadecimal color code
 	HexColor = validation.NewStringRuleWithError(govalidator.IsHexcolor, ErrHexColor)
 	// RGBColor validates if a string is a valid RGB color in the form of rgb(R, G, B)
@@ -173,20 +200,29 @@ var (
 	UUIDv5 = validation.NewStringRuleWithError(govalidator.IsUUIDv5, ErrUUIDv5)
 	// UUID validates if a string is a valid UUID
 	UUID = validation.NewStringRuleWithError(govalidator.IsUUID, ErrUUID)
+	// NonZeroUUID validates if a string is not the nil UUID (00000000-0000-0000-0000-000000000000), which is a valid UUID
+	// that usually means the ID is not set. Other strings are not checked, so combine it with UUID to check the format.
+	NonZeroUUID = validation.NewStringRuleWithError(isNonZeroUUID, ErrNonZeroUUID)
 	// CreditCard validates if a string is a valid credit card number
 	CreditCard = validation.NewStringRuleWithError(govalidator.IsCreditCard, ErrCreditCard)
-	// ISBN10 validates if a string is an ISBN version 10
-	ISBN10 = validation.NewStringRuleWithError(govalidator.IsISBN10, ErrISBN10)
-	// ISBN13 validates if a string is an ISBN version 13
-	ISBN13 = validation.NewStringRuleWithError(govalidator.IsISBN13, ErrISBN13)
-	// ISBN validates if a string is an ISBN (either version 10 or 13)
+	// ISBN10 validates if a string is an ISBN version 10 with a correct check digit. Hyphens and spaces are ignored.
+	ISBN10 = validation.NewStringRuleWithError(hasCheckDigit(validation.CheckDigitISBN10), ErrISBN10)
+	// ISBN13 validates if a string is an ISBN version 13 with a correct check digit. Hyphens and spaces are ignored.
+	ISBN13 = validation.NewStringRuleWithError(hasCheckDigit(validation.CheckDigitISBN13), ErrISBN13)
+	// ISBN validates if a string is an ISBN (either version 10 or 13). Hyphens and spaces are ignored.
 	ISBN = validation.NewStringRuleWithError(isISBN, ErrISBN)
+	// EAN13 validates if a string is an EAN-13 barcode number with a correct check digit. Hyphens and spaces are ignored.
+	EAN13 = validation.NewStringRuleWithError(hasCheckDigit(validation.CheckDigitEAN13), ErrEAN13)
+	// UPCA validates if a string is a UPC-A barcode number with a correct check digit. Hyphens and spaces are ignored.
+	UPCA = validation.NewStringRuleWithError(isUPCA, ErrUPCA)
 	// JSON validates if a string is in valid JSON format
 	JSON = validation.NewStringRuleWithError(govalidator.IsJSON, ErrJSON)
-	// ASCII validates if a string contains ASCII characters only
-	ASCII = validation.NewStringRuleWithError(govalidator.IsASCII, ErrASCII)
-	// PrintableASCII validates if a string contains printable ASCII characters only
-	PrintableASCII = validation.NewStringRuleWithError(govalidator.IsPrintableASCII, ErrPrintableASCII)
+	// ASCII validates if a string contains ASCII characters (U+0000 to U+007F) only.
+	// The position of the first offending character is reported as the "position" error parameter.
+	ASCII = ASCIIRule{err: ErrASCII}
+	// PrintableASCII validates if a string contains printable ASCII characters (U+0020 to U+007E) only.
+	// The position of the first offending character is reported as the "position" error parameter.
+	PrintableASCII = ASCIIRule{printable: true, err: ErrPrintableASCII}
 	// Multibyte validates if a string contains multibyte characters
 	Multibyte = validation.NewStringRuleWithError(govalidator.IsMultibyte, ErrMultibyte)
 	// FullWidth validates if a string contains full-width characters
@@ -233,14 +269,53 @@ var (
 	Latitude = validation.NewStringRuleWithError(govalidator.IsLatitude, ErrLatitude)
 	// Longitude validates if a string is a valid longitude
 	Longitude = validation.NewStringRuleWithError(govalidator.IsLongitude, ErrLongitude)
-	// SSN validates if a string is a social security number (SSN)
-	SSN = validation.NewStringRuleWithError(govalidator.IsSSN, ErrSSN)
+	// SSN validates if a string is a U.S. social security number (SSN) in the XXX-XX-XXXX format.
+	// Numbers with the area 000, 666 or 900-999, the group 00 or the serial 0000 are never issued and are rejected.
+	SSN = validation.NewStringRuleWithError(isSSN, ErrSSN)
+	// EIN validates if a string is a U.S. employer identification number (EIN) in the XX-XXXXXXX format
+	// whose prefix is assigned to an IRS campus.
+	EIN = validation.NewStringRuleWithError(isEIN, ErrEIN)
 	// Semver validates if a string is a valid semantic version
 	Semver = validation.NewStringRuleWithError(govalidator.IsSemver, ErrSemver)
+	// TimeZone validates if a string is a valid IANA time zone name (e.g. America/New_York) that can be loaded
+	// by time.LoadLocation. The special names "UTC" and "Local" are also accepted. Note that the time zone database
+	// of the system is used unless the program imports the time/tzdata package.
+	TimeZone = validation.NewStringRuleWithError(isTimeZone, ErrTimeZone)
+	// LanguageTag validates if a string is a well-formed BCP 47 language tag (e.g. en-US, zh-Hant)
+	LanguageTag = validation.NewStringRuleWithError(isLanguageTag, ErrLanguageTag)
+	// ISODuration validates if a string is a valid ISO 8601 duration (e.g. P1Y2M10DT2H30M)
+	ISODuration = validation.NewStringRuleWithError(isISODuration, ErrISODuration)
+	// RFC3339 validates if a string is an RFC 3339 timestamp without fractional seconds (e.g. 2006-01-02T15:04:05+07:00),
+	// as formatted with time.RFC3339
+	RFC3339 = validation.NewStringRuleWithError(isRFC3339, ErrRFC3339)
+	// RFC3339Nano validates if a string is an RFC 3339 timestamp with optional fractional seconds
+	// (e.g. 2006-01-02T15:04:05.999999999Z), as formatted with time.RFC3339Nano
+	RFC3339Nano = validation.NewStringRuleWithError(isRFC3339Nano, ErrRFC3339)
 )
 
 var (
-	reDigit = regexp.MustCompile("^[0-9]+$")
+	reDigit       = regexp.MustCompile("^[0-9]+$")
+	reSSN         = regexp.MustCompile(`^([0-9]{3})-([0-9]{2})-([0-9]{4})$`)
+	reEIN         = regexp.MustCompile(`^([0-9]{2})-[0-9]{7}$`)
+	reHexadecimal = regexp.MustCompile(`^(?:0[xX])?[0-9a-fA-F]+$`)
+	reOctal       = regexp.MustCompile(`^(?:0[oO])?[0-7]+$`)
+	reBinary      = regexp.MustCompile(`^(?:0[bB])?[01]+$`)
+	// hyphens and spaces that separate the digit groups of ISBN, EAN and UPC numbers
+	reDigitSeparators = regexp.MustCompile(`[\s-]`)
+	// the fixed-width date and time that start an RFC 3339 timestamp
+	reRFC3339DateTime = regexp.MustCompile(`^[0-9]{4}-[0-9]{2}-[0-9]{2}T[0-9]{2}:[0-9]{2}:[0-9]{2}`)
+	// EIN prefixes assigned to the IRS campuses, source: https://www.irs.gov/businesses/small-businesses-self-employed/how-eins-are-assigned-and-valid-ein-prefixes
+	einPrefixes = map[string]bool{
+		"01": true, "02": true, "03": true, "04": true, "05": true, "06": true, "10": true, "11": true, "12": true, "13": true,
+		"14": true, "15": true, "16": true, "20": true, "21": true, "22": true, "23": true, "24": true, "25": true, "26": true,
+		"27": true, "30": true, "31": true, "32": true, "33": true, "34": true, "35": true, "36": true, "37": true, "38": true,
+		"39": true, "40": true, "41": true, "42": true, "43": true, "44": true, "45": true, "46": true, "47": true, "48": true,
+		"50": true, "51": true, "52": true, "53": true, "54": true, "55": true, "56": true, "57": true, "58": true, "59": true,
+		"60": true, "61": true, "62": true, "63": true, "64": true, "65": true, "66": true, "67": true, "68": true, "71": true,
+		"72": true, "73": true, "74": true, "75": true, "76": true, "77": true, "80": true, "81": true, "82": true, "83": true,
+		"84": true, "85": true, "86": true, "87": true, "88": true, "90": true, "91": true, "92": true, "93": true, "94": true,
+		"95": true, "98": true, "99": true,
+	}
 	// Subdomain regex source: https://stackoverflow.com/a/7933253
 	reSubdomain = regexp.MustCompile(`^[A-Za-z0-9](?:[A-Za-z0-9\-]{0,61}[A-Za-z0-9])?$`)
 	// E164 regex source: https://stackoverflow.com/a/23299989
@@ -252,7 +327,23 @@ var (
 )
 
 func isISBN(value string) bool {
-	return govalidator.IsISBN(value, 10) || govalidator.IsISBN(value, 13)
+	return hasCheckDigit(validation.CheckDigitISBN10)(value) || hasCheckDigit(validation.CheckDigitISBN13)(value)
+}
+
+// hasCheckDigit returns a function that checks the check digit of a value according to the named scheme,
+// ignoring hyphens and spaces.
+func hasCheckDigit(scheme string) func(string) bool {
+	rule := validation.CheckDigit(scheme)
+	return func(value string) bool {
+		value = reDigitSeparators.ReplaceAllString(value, "")
+		return value != "" && rule.Validate(value) == nil
+	}
+}
+
+// isUPCA checks a UPC-A number, which is an EAN-13 number with a leading zero omitted.
+func isUPCA(value string) bool {
+	value = reDigitSeparators.ReplaceAllString(value, "")
+	return len(value) == 12 && hasCheckDigit(validation.CheckDigitEAN13)("0"+value)
 }
 
 func isDigit(value string) bool {
@@ -275,6 +366,58 @@ func isDomain(value string) bool {
 	return reDomain.MatchString(value)
 }
 
+func isISODuration(value string) bool {
+	_, err := ParseISODuration(value)
+	return err == nil
+}
+
+func isRFC3339(value string) bool {
+	// time.Parse accepts fractional seconds even if the layout has none
+	n := len("2006-01-02T15:04:05")
+	return isRFC3339Nano(value) && len(value) > n && value[n] != '.'
+}
+
+func isRFC3339Nano(value string) bool {
+	// time.Parse also accepts single-digit fields, which RFC 3339 does not allow
+	if !reRFC3339DateTime.MatchString(value) {
+		return false
+	}
+	_, err := time.Parse(time.RFC3339Nano, value)
+	// time.Parse also accepts a comma as the decimal separator, which RFC 3339 does not allow
+	return err == nil && !strings.Contains(value, ",")
+}
+
+func isNonZeroUUID(value string) bool {
+	return strings.ReplaceAll(value, "-", "") != "00000000000000000000000000000000"
+}
+
+func isSSN(value string) bool {
+	m := reSSN.FindStringSubmatch(value)
+	if m == nil {
+		return false
+	}
+	area, group, serial := m[1], m[2], m[3]
+	return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+}
+
+func isEIN(value string) bool {
+	m := reEIN.FindStringSubmatch(value)
+	return m != nil && einPrefixes[m[1]]
+}
+
+func isTimeZone(value string) bool {
+	if value == "UTC" || value == "Local" {
+		return true
+	}
+	_, err := time.LoadLocation(value)
+	return err == nil
+}
+
+func isLanguageTag(value string) bool {
+	_, err := language.Parse(value)
+	return err == nil
+}
+
 func isUTFNumeric(value string) bool {
 	for _, c := range value {
 		if !unicode.IsNumber(c) {
@@ -7,6 +7,7 @@ package is
 import (
 	"strings"
 	"testing"
+	_ "time/tzdata" // makes the TimeZone tests independent of the system time zone database
 
 	"github.com/aboozaid/validation"
 	"github.com/stretchr/testify/assert"
@@ -45,11 +46,39 @@ func TestAll(t *testing.T) {
 		{"Port", Port, "123", "99999", "must be a valid port number"},
 		{"Latitude", Latitude, "23.123", "100", "must be a valid latitude"},
 		{"Longitude", Longitude, "123.123", "abc", "must be a valid longitude"},
-		{"SSN", SSN, "100-00-1000", "100-0001000", "must be a valid social security number"},
+		{"SSN", SSN, "123-45-6789", "100-0001000", "must be a valid Social Security Number"},
+		{"SSN", SSN, "899-99-9999", "123 45 6789", "must be a valid Social Security Number"},
+		{"SSN", SSN, "001-01-0001", "000-45-6789", "must be a valid Social Security Number"},
+		{"SSN", SSN, "665-45-6789", "666-45-6789", "must be a valid Social Security Number"},
+		{"SSN", SSN, "667-45-6789", "900-45-6789", "must be a valid Social Security Number"},
+		{"SSN", SSN, "123-01-6789", "123-00-6789", "must be a valid Social Security Number"},
+		{"SSN", SSN, "123-45-0001", "123-45-0000", "must be a valid Social Security Number"},
+		{"EIN", EIN, "12-3456789", "12-345678", "must be a valid EIN"},
+		{"EIN", EIN, "01-3456789", "123456789", "must be a valid EIN"},
+		{"EIN", EIN, "99-3456789", "00-3456789", "must be a valid EIN"},
+		{"EIN", EIN, "31-3456789", "07-3456789", "must be a valid EIN"},
+		{"EIN", EIN, "95-3456789", "89-3456789", "must be a valid EIN"},
 		{"Semver", Semver, "1.0.0", "1.0.0.0", "must be a valid semantic version"},
+		{"SemverRange", SemverRange, "^1.2.3", "^1.2.3.4", "must be a valid version range"},
+		{"SemverRange", SemverRange, "~1.2", "~~1.2", "must be a valid version range"},
+		{"SemverRange", SemverRange, ">=1.0.0 <2.0.0", ">=1.0.0 <", "must be a valid version range"},
+		{"SemverRange", SemverRange, ">= 1.0.0 < 2.0.0-rc.1", "=> 1.0.0", "must be a valid version range"},
+		{"SemverRange", SemverRange, "1.2.3 - 2.3.4", "1.2.3 - ^2.3.4", "must be a valid version range"},
+		{"SemverRange", SemverRange, "1.x || >=2.5.0 || 5.0.0 - 7.2.3", "1.x ||", "must be a valid version range"},
+		{"SemverRange", SemverRange, "*", "latest", "must be a valid version range"},
+		{"SemverRange", SemverRange, "v1.2.3+build.5", "01.2.3", "must be a valid version range"},
+		{"SemverRange", SemverRange, "~>1.2.X", "1.2.3-", "must be a valid version range"},
 		{"ISBN", ISBN, "1-61729-085-8", "1-61729-085-81", "must be a valid ISBN"},
 		{"ISBN10", ISBN10, "1-61729-085-8", "1-61729-085-81", "must be a valid ISBN-10"},
 		{"ISBN13", ISBN13, "978-4-87311-368-5", "978-4-87311-368-a", "must be a valid ISBN-13"},
+		{"ISBN", ISBN, "978 0 306 40615 7", "978-0-306-40615-8", "must be a valid ISBN"},
+		{"ISBN10", ISBN10, "0-8044-2957-X", "0-8044-2957-9", "must be a valid ISBN-10"},
+		{"ISBN13", ISBN13, "9780306406157", "4006381333931", "must be a valid ISBN-13"},
+		{"EAN13", EAN13, "4006381333931", "4006381333932", "must be a valid EAN-13"},
+		{"EAN13", EAN13, "4 006381 333931", "400638133393", "must be a valid EAN-13"},
+		{"UPCA", UPCA, "036000291452", "036000291453", "must be a valid UPC-A"},
+		{"UPCA", UPCA, "0 36000 29145 2", "4006381333931", "must be a valid UPC-A"},
+		{"UPCA", UPCA, "036000291452", " - ", "must be a valid UPC-A"},
 		{"UUID", UUID, "a987fbc9-4bed-3078-cf07-9141ba07c9f1", "a987fbc9-4bed-3078-cf07-9141ba07c9f3a", "must be a valid UUID"},
 		{"UUIDv3", UUIDv3, "b987fbc9-4bed-3078-cf07-9141ba07c9f3", "b987fbc9-4bed-4078-cf07-9141ba07c9f3", "must be a valid UUID v3"},
 		{"UUIDv4", UUIDv4, "57b73598-8764-4ad0-a76a-679bb6640eb1", "b987fbc9-4bed-3078-cf07-9141ba07c9f3", "must be a valid UUID v4"},
@@ -59,6 +88,9 @@ func TestAll(t *testing.T) {
 		{"JSON", JSON, "[1, 2]", "[1, 2,]", "must be in valid JSON format"},
 		{"ASCII", ASCII, "abc", "ａabc", "must contain ASCII characters only"},
 		{"PrintableASCII", PrintableASCII, "abc", "ａabc", "must contain printable ASCII characters only"},
+		{"PrintableASCII", PrintableASCII, "a b~", "a\tb", "must contain printable ASCII characters only"},
+		{"PrintableASCII", PrintableASCII, "a b~", "a\x7fb", "must contain printable ASCII characters only"},
+		{"ASCII", ASCII, "a\tb\x7f", "a\xffb", "must contain ASCII characters only"},
 		{"E164", E164, "+19251232233", "+00124222333", "must be a valid E164 number"},
 		{"CountryCode2", CountryCode2, "US", "XY", "must be a valid two-letter country code"},
 		{"CountryCode3", CountryCode3, "USA", "XYZ", "must be a valid three-letter country code"},
@@ -71,10 +103,37 @@ func TestAll(t *testing.T) {
 		{"HalfWidth", HalfWidth, "abc123い", "００１１", "must contain half-width characters"},
 		{"VariableWidth", VariableWidth, "３ー０123", "abc", "must contain both full-width and half-width characters"},
 		{"Hexadecimal", Hexadecimal, "FEF", "FTF", "must be a valid hexadecimal number"},
+		{"Hexadecimal", Hexadecimal, "0x1aF", "0x", "must be a valid hexadecimal number"},
+		{"Hexadecimal", Hexadecimal, "0XFF", "x1F", "must be a valid hexadecimal number"},
+		{"Octal", Octal, "0755", "0758", "must be a valid octal number"},
+		{"Octal", Octal, "0o755", "0o", "must be a valid octal number"},
+		{"Binary", Binary, "1010", "1012", "must be a valid binary number"},
+		{"Binary", Binary, "0B1010", "0b", "must be a valid binary number"},
 		{"HexColor", HexColor, "F00", "FTF", "must be a valid hexadecimal color code"},
 		{"RGBColor", RGBColor, "rgb(100, 200, 1)", "abc", "must be a valid RGB color code"},
 		{"Int", Int, "100", "1.1", "must be an integer number"},
 		{"Float", Float, "1.1", "a.1", "must be a floating point number"},
+		{"TimeZone", TimeZone, "America/New_York", "America/New_Yrok", "must be a valid IANA time zone"},
+		{"TimeZone", TimeZone, "UTC", "utc/", "must be a valid IANA time zone"},
+		{"TimeZone", TimeZone, "Local", "../etc/passwd", "must be a valid IANA time zone"},
+		{"TimeZone", TimeZone, "Europe/Berlin", "GMT+2", "must be a valid IANA time zone"},
+		{"LanguageTag", LanguageTag, "en-US", "en-", "must be a valid language tag"},
+		{"LanguageTag", LanguageTag, "zh-Hant", "english", "must be a valid language tag"},
+		{"LanguageTag", LanguageTag, "sr-Latn-RS", "en-US-x", "must be a valid language tag"},
+		{"LanguageTag", LanguageTag, "de-CH-1996", "123", "must be a valid language tag"},
+		{"ISODuration", ISODuration, "P1Y2M10DT2H30M", "P1Y2M10DT", "must be a valid ISO 8601 duration"},
+		{"NonZeroUUID", NonZeroUUID, "a987fbc9-4bed-3078-cf07-9141ba07c9f3", "00000000-0000-0000-0000-000000000000", "must not be the nil UUID"},
+		{"NonZeroUUID", NonZeroUUID, "00000000-0000-0000-0000-000000000001", "00000000000000000000000000000000", "must not be the nil UUID"},
+		{"RFC3339", RFC3339, "2006-01-02T15:04:05+07:00", "2006-01-02T15:04:05.123Z", "must be an RFC 3339 timestamp"},
+		{"RFC3339", RFC3339, "2006-01-02T15:04:05Z", "2006-01-02 15:04:05Z", "must be an RFC 3339 timestamp"},
+		{"RFC3339", RFC3339, "2024-02-29T23:59:59-01:30", "2023-02-29T00:00:00Z", "must be an RFC 3339 timestamp"},
+		{"RFC3339", RFC3339, "2006-01-02T15:04:05Z", "2006-01-02T15:04:05", "must be an RFC 3339 timestamp"},
+		{"RFC3339", RFC3339, "2006-01-02T01:04:05Z", "2006-01-02T1:04:05Z", "must be an RFC 3339 timestamp"},
+		{"RFC3339", RFC3339, "2006-01-02T15:04:05Z", "2006-1-02T15:04:05Z", "must be an RFC 3339 timestamp"},
+		{"RFC3339Nano", RFC3339Nano, "2006-01-02T15:04:05.999999999Z", "2006-01-02T15:04:05,5Z", "must be an RFC 3339 timestamp"},
+		{"RFC3339Nano", RFC3339Nano, "2006-01-02T15:04:05+07:00", "2006-01-02T15:04:05.Z", "must be an RFC 3339 timestamp"},
+		{"RFC3339Nano", RFC3339Nano, "2006-01-02T15:04:05.5-07:00", "2006-01-02T15:04:05.5+0700", "must be an RFC 3339 timestamp"},
+		{"RFC3339Nano", RFC3339Nano, "2006-01-02T01:04:05.5Z", "2006-01-02T1:04:05.5Z", "must be an RFC 3339 timestamp"},
 		{"VariableWidth", VariableWidth, "", "", ""},
 	}
 
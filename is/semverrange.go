@@ -0,0 +1,52 @@
+package is
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/aboozaid/validation"
+)
+
+// ErrSemverRange is the error that returns in case of an invalid semantic version range.
+var ErrSemverRange = validation.NewError("validation_is_semver_range", "must be a valid version range")
+
+// SemverRange validates if a string is a valid semantic version range as used by package managers such as npm,
+// e.g. "^1.2.3", "~1.2", ">=1.0.0 <2.0.0", "1.2.3 - 2.3.4", "1.x || >=2.5.0" or "*".
+// Versions may be partial and use "x", "X" or "*" as wildcards.
+var SemverRange = validation.NewStringRuleWithError(isSemverRange, ErrSemverRange)
+
+const (
+	semverPart    = `(?:0|[1-9][0-9]*|[xX*])`
+	semverIdents  = `[0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*`
+	semverPartial = `v?` + semverPart + `(?:\.` + semverPart + `(?:\.` + semverPart +
+		`(?:-` + semverIdents + `)?(?:\+` + semverIdents + `)?)?)?`
+)
+
+var (
+	rePartialVersion = regexp.MustCompile(`^` + semverPartial + `$`)
+	reComparator     = regexp.MustCompile(`^(?:\^|~>?|>=|<=|>|<|=)?` + semverPartial + `$`)
+	reHyphenRange    = regexp.MustCompile(`^(\S+)\s+-\s+(\S+)$`)
+	// an operator separated from its version by white space, e.g. ">= 1.2.3"
+	reOperatorSpace = regexp.MustCompile(`(\^|~>?|>=|<=|>|<|=)\s+`)
+)
+
+func isSemverRange(value string) bool {
+	for _, set := range strings.Split(value, "||") {
+		set = strings.TrimSpace(set)
+		if set == "" {
+			return false
+		}
+		if m := reHyphenRange.FindStringSubmatch(set); m != nil {
+			if !rePartialVersion.MatchString(m[1]) || !rePartialVersion.MatchString(m[2]) {
+				return false
+			}
+			continue
+		}
+		for _, comparator := range strings.Fields(reOperatorSpace.ReplaceAllString(set, "$1")) {
+			if !reComparator.MatchString(comparator) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,16 @@
+package is
+
+import (
+	"reflect"
+
+	"github.com/aboozaid/validation"
+)
+
+func init() {
+	validation.RegisterTagRule("email", func(reflect.Type, string) (validation.Rule, error) {
+		return EmailFormat, nil
+	})
+	validation.RegisterTagRule("url", func(reflect.Type, string) (validation.Rule, error) {
+		return URL, nil
+	})
+}
@@ -0,0 +1,22 @@
+package is
+
+import (
+	"testing"
+
+	"github.com/aboozaid/validation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTagged(t *testing.T) {
+	type user struct {
+		Email   string `json:"email" validate:"required,email"`
+		Website string `json:"website" validate:"url"`
+	}
+
+	u := user{Email: "test@example.com", Website: "http://example.com"}
+	assert.Nil(t, validation.ValidateTagged(&u))
+
+	u = user{Email: "example.com", Website: "examplecom"}
+	err := validation.ValidateTagged(&u)
+	assert.EqualError(t, err, "email: must be a valid email address; website: must be a valid URL.")
+}
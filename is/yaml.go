@@ -0,0 +1,80 @@
+package is
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/aboozaid/validation"
+)
+
+// ErrYAML is the error that returns in case of an invalid YAML document.
+var ErrYAML = validation.NewError("validation_is_yaml", "must be valid YAML")
+
+// errNoYAMLValidator is the internal error that returns when YAML is used before a YAML validator is set.
+var errNoYAMLValidator = errors.New("is.YAML requires a YAML validator to be set by calling is.SetYAMLValidator")
+
+var (
+	yamlValidator   func(data []byte) error
+	yamlValidatorMu sync.RWMutex
+)
+
+// YAML validates if a string is a valid YAML document. To keep this package free of a YAML dependency,
+// the document is checked by the function set with SetYAMLValidator. An InternalError is returned
+// if no function has been set.
+var YAML = YAMLRule{err: ErrYAML}
+
+// SetYAMLValidator sets the function used by the YAML rule to check if a document is valid YAML.
+// The function should return an error if the document cannot be parsed. For example, with gopkg.in/yaml.v3,
+//
+//	is.SetYAMLValidator(func(data []byte) error {
+//	    var v interface{}
+//	    return yaml.Unmarshal(data, &v)
+//	})
+func SetYAMLValidator(f func(data []byte) error) {
+	yamlValidatorMu.Lock()
+	defer yamlValidatorMu.Unlock()
+	yamlValidator = f
+}
+
+// YAMLRule is a validation rule that checks if a string is a valid YAML document.
+type YAMLRule struct {
+	err validation.Error
+}
+
+// Error sets the error message for the rule.
+// The error returned by the YAML validator is available as the "error" parameter.
+func (r YAMLRule) Error(message string) YAMLRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r YAMLRule) ErrorObject(err validation.Error) YAMLRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r YAMLRule) Validate(value interface{}) error {
+	yamlValidatorMu.RLock()
+	f := yamlValidator
+	yamlValidatorMu.RUnlock()
+	if f == nil {
+		return validation.NewInternalError(errNoYAMLValidator)
+	}
+
+	value, isNil := validation.Indirect(value)
+	if isNil || validation.IsEmpty(value) {
+		return nil
+	}
+
+	str, err := validation.EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	if err := f([]byte(str)); err != nil {
+		return r.err.SetParams(map[string]interface{}{"error": err.Error()})
+	}
+	return nil
+}
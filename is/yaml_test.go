@@ -0,0 +1,54 @@
+package is
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aboozaid/validation"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestYAML(t *testing.T) {
+	err := YAML.Validate("a: 1")
+	if assert.NotNil(t, err) {
+		_, ok := err.(validation.InternalError)
+		assert.True(t, ok)
+	}
+
+	SetYAMLValidator(func(data []byte) error {
+		if strings.Contains(string(data), "\t") {
+			return errors.New("found a tab character")
+		}
+		return nil
+	})
+	defer SetYAMLValidator(nil)
+
+	var s *string
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", "a: 1", ""},
+		{"t2", "a:\n\tb: 1", "must be valid YAML"},
+		{"t3", []byte("a: 1"), ""},
+		{"t4", "", ""},
+		{"t5", s, ""},
+		{"t6", 1, "must be either a string or byte slice"},
+	}
+	for _, test := range tests {
+		err := YAML.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err = YAML.Error("invalid YAML: {{.error}}").Validate("\t")
+	assertError(t, "invalid YAML: found a tab character", err, "t7")
+}
+
+func TestYAMLRule_ErrorObject(t *testing.T) {
+	err := validation.NewError("code", "abc")
+	r := YAML.ErrorObject(err)
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, "must be valid YAML", YAML.err.Message())
+}
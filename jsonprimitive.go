@@ -0,0 +1,55 @@
+package validation
+
+import "reflect"
+
+// ErrNotJSONPrimitive is the error that returns when a value is not a JSON primitive value.
+var ErrNotJSONPrimitive = NewError("validation_not_json_primitive", "must be a primitive value")
+
+// JSONPrimitive is a validation rule that checks if a value is a JSON primitive value, i.e. a string, a boolean,
+// a number (such as a float64 or a json.Number) or nil, as opposed to an object or an array. This is useful for
+// validating the leaf nodes of a generic tree, such as one decoded into an interface{} by encoding/json.
+// Pointers are dereferenced. A nil pointer, map or slice is considered valid as it is encoded as null, but unlike
+// most rules, an empty map or slice is invalid because it is still an object or an array.
+var JSONPrimitive = JSONPrimitiveRule{}
+
+// JSONPrimitiveRule is a validation rule that checks if a value is a JSON primitive value.
+type JSONPrimitiveRule struct {
+	err Error
+}
+
+// Error sets the error message for the rule.
+func (r JSONPrimitiveRule) Error(message string) JSONPrimitiveRule {
+	r.err = r.defaultError().SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r JSONPrimitiveRule) ErrorObject(err Error) JSONPrimitiveRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r JSONPrimitiveRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || value == nil {
+		return nil
+	}
+
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return nil
+	}
+	return r.defaultError()
+}
+
+// defaultError returns the error set for the rule, or ErrNotJSONPrimitive.
+func (r JSONPrimitiveRule) defaultError() Error {
+	if r.err != nil {
+		return r.err
+	}
+	return ErrNotJSONPrimitive
+}
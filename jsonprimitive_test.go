@@ -0,0 +1,57 @@
+package validation
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONPrimitive(t *testing.T) {
+	var s *string
+	var m map[string]interface{}
+	str := "abc"
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", "abc", ""},
+		{"t2", "", ""},
+		{"t3", true, ""},
+		{"t4", 1.5, ""},
+		{"t5", json.Number("12"), ""},
+		{"t6", 12, ""},
+		{"t7", nil, ""},
+		{"t8", s, ""},
+		{"t9", &str, ""},
+		{"t10", map[string]interface{}{"a": 1}, "must be a primitive value"},
+		{"t11", map[string]interface{}{}, "must be a primitive value"},
+		{"t12", []interface{}{1}, "must be a primitive value"},
+		{"t13", []interface{}{}, "must be a primitive value"},
+		{"t14", struct{}{}, "must be a primitive value"},
+		{"t15", m, ""},
+	}
+
+	for _, test := range tests {
+		err := JSONPrimitive.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func Test_JSONPrimitiveRule_Error(t *testing.T) {
+	r := JSONPrimitive.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+	assert.Equal(t, "validation_not_json_primitive", r.err.Code())
+	assert.Nil(t, JSONPrimitive.err)
+}
+
+func TestJSONPrimitiveRule_ErrorObject(t *testing.T) {
+	r := JSONPrimitive
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,163 @@
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// ErrJSONSchemaInvalid is the error that returns when a value does not conform to a JSON schema.
+var ErrJSONSchemaInvalid = NewError("validation_json_schema_invalid", "{{.message}}")
+
+// jsonSchemaURL is the base URL under which the schema documents are compiled.
+const jsonSchemaURL = "schema.json"
+
+// JSONSchemaRule is a validation rule that validates a value against a JSON schema document.
+type JSONSchemaRule struct {
+	schema     *jsonschema.Schema
+	compileErr error
+	err        Error
+}
+
+// JSONSchema returns a validation rule that checks if a value conforms to the given JSON schema document.
+// The value can be a json.RawMessage or a byte slice holding a JSON document, or any value that can be
+// encoded into JSON, such as a map[string]interface{}.
+//
+// Schema violations are reported as Errors indexed by the path of the offending value, e.g.
+//
+//	rule := validation.JSONSchema([]byte(`{
+//	    "type": "object",
+//	    "properties": {"age": {"type": "integer", "minimum": 0}}
+//	}`))
+//	err := validation.Validate(map[string]interface{}{"age": -1}, rule)
+//	fmt.Println(err)
+//	// age: must be >= 0 but found -1.
+//
+// If the schema cannot be compiled, an InternalError is returned when validating.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func JSONSchema(schema []byte) JSONSchemaRule {
+	r := JSONSchemaRule{err: ErrJSONSchemaInvalid}
+	c := jsonschema.NewCompiler()
+	if err := c.AddResource(jsonSchemaURL, bytes.NewReader(schema)); err != nil {
+		r.compileErr = err
+		return r
+	}
+	r.schema, r.compileErr = c.Compile(jsonSchemaURL)
+	return r
+}
+
+// Error sets the error message for the rule.
+// The message of the schema violation is available as the "message" parameter.
+func (r JSONSchemaRule) Error(message string) JSONSchemaRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r JSONSchemaRule) ErrorObject(err Error) JSONSchemaRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r JSONSchemaRule) Validate(value interface{}) error {
+	if r.compileErr != nil {
+		return NewInternalError(r.compileErr)
+	}
+
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	doc, err := toJSONDocument(value)
+	if err != nil {
+		return err
+	}
+
+	err = r.schema.Validate(doc)
+	if err == nil {
+		return nil
+	}
+	ve, ok := err.(*jsonschema.ValidationError)
+	if !ok {
+		return NewInternalError(err)
+	}
+
+	messages := map[string][]string{}
+	collectSchemaErrors(ve, messages)
+	return r.buildErrors("", messages)
+}
+
+// toJSONDocument converts a value into a generic JSON document as expected by the schema validator.
+func toJSONDocument(value interface{}) (interface{}, error) {
+	var data []byte
+	switch v := value.(type) {
+	case json.RawMessage:
+		data = v
+	case []byte:
+		data = v
+	default:
+		var err error
+		if data, err = json.Marshal(value); err != nil {
+			return nil, NewInternalError(err)
+		}
+	}
+
+	var doc interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	if err := d.Decode(&doc); err != nil {
+		return nil, errors.New("must be in valid JSON format")
+	}
+	return doc, nil
+}
+
+// collectSchemaErrors collects the messages of the leaf schema errors indexed by their instance locations.
+func collectSchemaErrors(ve *jsonschema.ValidationError, messages map[string][]string) {
+	if len(ve.Causes) == 0 {
+		messages[ve.InstanceLocation] = append(messages[ve.InstanceLocation], ve.Message)
+		return
+	}
+	for _, cause := range ve.Causes {
+		collectSchemaErrors(cause, messages)
+	}
+}
+
+// buildErrors converts the schema error messages found at or below the given location into a validation error.
+// Errors found below the location are returned as nested Errors. Errors at a location that also has nested
+// errors are indexed by an empty key.
+func (r JSONSchemaRule) buildErrors(location string, messages map[string][]string) error {
+	var own error
+	if msgs, ok := messages[location]; ok {
+		own = r.err.SetParams(map[string]interface{}{"message": strings.Join(msgs, "; ")})
+	}
+
+	children := map[string]bool{}
+	prefix := location + "/"
+	for loc := range messages {
+		if strings.HasPrefix(loc, prefix) {
+			children[strings.SplitN(loc[len(prefix):], "/", 2)[0]] = true
+		}
+	}
+	if len(children) == 0 {
+		return own
+	}
+
+	errs := Errors{}
+	for name := range children {
+		errs[unescapeJSONPointer(name)] = r.buildErrors(prefix+name, messages)
+	}
+	if own != nil {
+		errs[""] = own
+	}
+	return errs
+}
+
+// unescapeJSONPointer unescapes a JSON pointer reference token.
+func unescapeJSONPointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+}
@@ -0,0 +1,69 @@
+package validation
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSchema = `{
+	"type": "object",
+	"properties": {
+		"name": {"type": "string", "minLength": 2},
+		"age": {"type": "integer", "minimum": 0},
+		"tags": {"type": "array", "items": {"type": "string"}},
+		"address": {
+			"type": "object",
+			"properties": {"zip": {"type": "string", "pattern": "^[0-9]{5}$"}},
+			"required": ["zip"]
+		}
+	},
+	"required": ["name"]
+}`
+
+func TestJSONSchema(t *testing.T) {
+	rule := JSONSchema([]byte(testSchema))
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", nil, ""},
+		{"t2", map[string]interface{}{}, ""},
+		{"t3", map[string]interface{}{"name": "John", "age": 30}, ""},
+		{"t4", map[string]interface{}{"name": "J", "age": -1}, "age: must be >= 0 but found -1; name: length must be >= 2, but got 1."},
+		{"t5", map[string]interface{}{"age": 1}, "missing properties: 'name'"},
+		{"t6", json.RawMessage(`{"name": "John", "tags": ["a", 1]}`), "tags: (1: expected string, but got number.)."},
+		{"t7", []byte(`{"name": "John", "address": {"zip": "abc"}}`), "address: (zip: does not match pattern '^[0-9]{5}$'.)."},
+		{"t8", json.RawMessage(`{"address": {}}`), ": missing properties: 'name'; address: missing properties: 'zip'."},
+		{"t9", json.RawMessage(`{"name": "John"`), "must be in valid JSON format"},
+		{"t10", map[string]int{"age": -1}, ": missing properties: 'name'; age: must be >= 0 but found -1."},
+	}
+
+	for _, test := range tests {
+		err := Validate(test.value, rule)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestJSONSchema_InvalidSchema(t *testing.T) {
+	err := Validate(map[string]interface{}{"a": 1}, JSONSchema([]byte(`{"type": 1`)))
+	if assert.NotNil(t, err) {
+		_, ok := err.(InternalError)
+		assert.True(t, ok)
+	}
+}
+
+func TestJSONSchemaRule_Error(t *testing.T) {
+	r := JSONSchema([]byte(`{"type": "object", "properties": {"a": {"type": "string"}}}`))
+	assert.EqualError(t, r.Validate(map[string]interface{}{"a": 1}), "a: expected string, but got number.")
+
+	r = r.Error("invalid value: {{.message}}")
+	assert.EqualError(t, r.Validate(map[string]interface{}{"a": 1}), "a: invalid value: expected string, but got number.")
+
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+	assert.Equal(t, err, r.err)
+}
@@ -0,0 +1,69 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"sort"
+)
+
+// ErrKeysMismatch is the error that returns when some keys of a map do not match the required pattern.
+var ErrKeysMismatch = NewError("validation_keys_mismatch", "map keys must match the required format")
+
+// KeysMatchRule is a validation rule that checks if the keys of a map match a regular expression.
+type KeysMatchRule struct {
+	re  *regexp.Regexp
+	err Error
+}
+
+// KeysMatch returns a validation rule that checks if all keys of a map with string keys match the specified
+// regular expression, e.g. to make sure metadata keys are in lowercase snake_case:
+//
+//	err := validation.Validate(metadata, validation.KeysMatch(regexp.MustCompile(`^[a-z][a-z0-9_]*$`)))
+//
+// The keys that do not match are reported in ascending order as the "keys" parameter of the error.
+// Use Each to validate the values of the map.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func KeysMatch(re *regexp.Regexp) KeysMatchRule {
+	return KeysMatchRule{
+		re:  re,
+		err: ErrKeysMismatch,
+	}
+}
+
+// Error sets the error message for the rule.
+func (r KeysMatchRule) Error(message string) KeysMatchRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r KeysMatchRule) ErrorObject(err Error) KeysMatchRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r KeysMatchRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Map || v.Type().Key().Kind() != reflect.String {
+		return errors.New("must be a map with string keys")
+	}
+
+	var invalid []string
+	for _, key := range v.MapKeys() {
+		if k := key.String(); !r.re.MatchString(k) {
+			invalid = append(invalid, k)
+		}
+	}
+	if len(invalid) == 0 {
+		return nil
+	}
+	sort.Strings(invalid)
+	return r.err.SetParams(map[string]interface{}{"keys": invalid})
+}
@@ -0,0 +1,57 @@
+package validation
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeysMatch(t *testing.T) {
+	type key string
+	var m map[string]int
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", map[string]int{"user_id": 1, "plan": 2}, ""},
+		{"t2", map[string]int{"user_id": 1, "UserName": 2}, "map keys must match the required format"},
+		{"t3", map[key]string{"a1": "x"}, ""},
+		{"t4", &map[string]bool{"-x": true}, "map keys must match the required format"},
+		{"t5", map[string]int{}, ""},
+		{"t6", m, ""},
+		{"t7", nil, ""},
+		{"t8", map[int]int{1: 1}, "must be a map with string keys"},
+		{"t9", []string{"a"}, "must be a map with string keys"},
+	}
+
+	rule := KeysMatch(regexp.MustCompile(`^[a-z][a-z0-9_]*$`))
+	for _, test := range tests {
+		err := rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := rule.Validate(map[string]int{"ok": 1, "Zeta": 2, "Alpha": 3})
+	if assert.IsType(t, ErrorObject{}, err) {
+		assert.Equal(t, []string{"Alpha", "Zeta"}, err.(ErrorObject).Params()["keys"])
+	}
+}
+
+func Test_KeysMatchRule_Error(t *testing.T) {
+	r := KeysMatch(regexp.MustCompile("^[a-z]+$"))
+	assert.Equal(t, "map keys must match the required format", r.err.Message())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestKeysMatchRule_ErrorObject(t *testing.T) {
+	r := KeysMatch(regexp.MustCompile("^[a-z]+$"))
+
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -5,6 +5,8 @@
 package validation
 
 import (
+	"reflect"
+	"strings"
 	"unicode/utf8"
 )
 
@@ -45,8 +47,17 @@ func RuneLength(min, max int) LengthRule {
 type LengthRule struct {
 	err Error
 
-	min, max int
-	rune     bool
+	min, max  int
+	rune      bool
+	trimSpace bool
+}
+
+// TrimSpace makes the rule measure the length of a string after removing its leading and trailing white space,
+// so that padding does not count toward the length limits. Values other than strings are not affected.
+// Note that a non-empty string consisting of white space only has a length of 0 after trimming.
+func (r LengthRule) TrimSpace() LengthRule {
+	r.trimSpace = true
+	return r
 }
 
 // Validate checks if the given value is valid or not.
@@ -60,16 +71,33 @@ func (r LengthRule) Validate(value interface{}) error {
 		l   int
 		err error
 	)
-	if s, ok := value.(string); ok && r.rune {
-		l = utf8.RuneCountInString(s)
+	if rv := reflect.ValueOf(value); r.trimSpace && rv.Kind() == reflect.String {
+		l = r.stringLength(rv.String())
+	} else if s, ok := value.(string); ok && r.rune {
+		l = r.stringLength(s)
 	} else if l, err = LengthOfValue(value); err != nil {
 		return err
 	}
 
+	return r.checkLength(l)
+}
+
+// stringLength returns the length of a string as measured by the rule.
+func (r LengthRule) stringLength(s string) int {
+	if r.trimSpace {
+		s = strings.TrimSpace(s)
+	}
+	if r.rune {
+		return utf8.RuneCountInString(s)
+	}
+	return len(s)
+}
+
+// checkLength returns the error of the rule if the given length is out of the range.
+func (r LengthRule) checkLength(l int) error {
 	if r.min > 0 && l < r.min || r.max > 0 && l > r.max || r.min == 0 && r.max == 0 && l > 0 {
 		return r.err
 	}
-
 	return nil
 }
 
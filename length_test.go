@@ -79,6 +79,36 @@ func TestRuneLength(t *testing.T) {
 	}
 }
 
+type paddedName string
+
+func TestLengthRule_TrimSpace(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  LengthRule
+		value interface{}
+		err   string
+	}{
+		{"t1", Length(5, 10).TrimSpace(), "  abcde  ", ""},
+		{"t2", Length(5, 10).TrimSpace(), "  abc  ", "the length must be between 5 and 10"},
+		{"t3", Length(2, 4).TrimSpace(), "\tabcde\n", "the length must be between 2 and 4"},
+		{"t4", Length(2, 4).TrimSpace(), "   ", "the length must be between 2 and 4"},
+		{"t5", Length(2, 4).TrimSpace(), "", ""},
+		{"t6", Length(2, 4).TrimSpace(), paddedName(" ab "), ""},
+		{"t7", Length(2, 4).TrimSpace(), paddedName(" a "), "the length must be between 2 and 4"},
+		{"t8", Length(2, 4).TrimSpace(), []byte("  abcdef  "), "the length must be between 2 and 4"},
+		{"t9", Length(2, 4).TrimSpace(), []int{1, 2}, ""},
+		{"t10", RuneLength(2, 3).TrimSpace(), " 💥💥💥 ", ""},
+		{"t11", RuneLength(2, 3).TrimSpace(), " 💥 ", "the length must be between 2 and 3"},
+		{"t12", Length(0, 3).TrimSpace(), " abc ", ""},
+		{"t13", Length(0, 3), " abc ", "the length must be no more than 3"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
 func Test_LengthRule_Error(t *testing.T) {
 	r := Length(10, 20)
 	assert.Equal(t, "the length must be between 10 and 20", r.Validate("abc").Error())
@@ -0,0 +1,143 @@
+package validation
+
+import "context"
+
+// LookupFunc looks up a value in an external source, such as a database, with the given context.
+// It returns whether the value is valid according to the source, or an error if the lookup fails.
+type LookupFunc func(ctx context.Context, value interface{}) (bool, error)
+
+var (
+	// ErrNotUnique is the error that returns when a value is already taken.
+	ErrNotUnique = NewError("validation_not_unique", "is already taken")
+	// ErrNotExist is the error that returns when a referenced value does not exist.
+	ErrNotExist = NewError("validation_not_exist", "referenced value does not exist")
+)
+
+// UniqueRule is a validation rule that checks if a value is unique according to a lookup function.
+type UniqueRule struct {
+	isUnique LookupFunc
+	err      Error
+}
+
+// Unique returns a validation rule that checks if a value is unique, such as an email address that
+// must not belong to an existing user, by calling the given function with the validation context:
+//
+//	rule := validation.Unique(func(ctx context.Context, value interface{}) (bool, error) {
+//	    var n int
+//	    err := db.QueryRowContext(ctx, "SELECT COUNT(*) FROM users WHERE email = $1", value).Scan(&n)
+//	    return n == 0, err
+//	})
+//	err := validation.ValidateWithContext(ctx, signup.Email, validation.Required, is.EmailFormat, rule)
+//
+// The function receives the value with pointers dereferenced, and should return false if the value is taken.
+// An error returned by the function that is not a validation Error, such as a database failure, is returned as
+// an InternalError so that it is not mistaken for a validation failure. If the context is done before the lookup,
+// its error is returned as an InternalError without calling the function.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Unique(isUnique LookupFunc) UniqueRule {
+	return UniqueRule{
+		isUnique: isUnique,
+		err:      ErrNotUnique,
+	}
+}
+
+// Error sets the error message for the rule.
+func (r UniqueRule) Error(message string) UniqueRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r UniqueRule) ErrorObject(err Error) UniqueRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not using a background context.
+func (r UniqueRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(context.Background(), value)
+}
+
+// ValidateWithContext checks if the given value is valid or not by looking it up with the given context.
+func (r UniqueRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	return validateLookup(ctx, r.isUnique, value, r.err)
+}
+
+// ExistsRule is a validation rule that checks if a value exists according to a lookup function.
+type ExistsRule struct {
+	exists LookupFunc
+	err    Error
+}
+
+// Exists returns a validation rule that checks if a value references an existing entity, such as the ID of
+// a category, by calling the given function with the validation context:
+//
+//	rule := validation.Exists(func(ctx context.Context, value interface{}) (bool, error) {
+//	    return categories.Exists(ctx, value.(int64))
+//	})
+//	err := validation.ValidateWithContext(ctx, product.CategoryID, validation.Required, rule)
+//
+// The function should return false if the value does not exist. Please refer to Unique for how the context
+// and the errors returned by the function are handled.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Exists(exists LookupFunc) ExistsRule {
+	return ExistsRule{
+		exists: exists,
+		err:    ErrNotExist,
+	}
+}
+
+// Error sets the error message for the rule.
+func (r ExistsRule) Error(message string) ExistsRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r ExistsRule) ErrorObject(err Error) ExistsRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not using a background context.
+func (r ExistsRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(context.Background(), value)
+}
+
+// ValidateWithContext checks if the given value is valid or not by looking it up with the given context.
+func (r ExistsRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	return validateLookup(ctx, r.exists, value, r.err)
+}
+
+// validateLookup checks a value with the given lookup function and returns the given error if the function returns false.
+func validateLookup(ctx context.Context, f LookupFunc, value interface{}, failure Error) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := ctx.Err(); err != nil {
+		return NewInternalError(err)
+	}
+
+	ok, err := f(ctx, value)
+	if err != nil {
+		return lookupError(err)
+	}
+	if !ok {
+		return failure
+	}
+	return nil
+}
+
+// lookupError converts an error returned by a lookup function into an InternalError unless it is a validation Error.
+func lookupError(err error) error {
+	switch err.(type) {
+	case Error, InternalError:
+		return err
+	}
+	return NewInternalError(err)
+}
@@ -0,0 +1,131 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// takenEmails is a lookup source for tests. The "fail" value simulates a failed lookup.
+func takenEmails(ctx context.Context, value interface{}) (bool, error) {
+	switch value {
+	case "fail":
+		return false, errors.New("connection refused")
+	case "custom":
+		return false, NewError("code", "custom error")
+	}
+	return value != "taken@example.com", nil
+}
+
+func TestUnique(t *testing.T) {
+	var s *string
+	taken := "taken@example.com"
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", "new@example.com", ""},
+		{"t2", "taken@example.com", "is already taken"},
+		{"t3", &taken, "is already taken"},
+		{"t4", "", ""},
+		{"t5", s, ""},
+		{"t6", "custom", "custom error"},
+	}
+
+	for _, test := range tests {
+		err := Unique(takenEmails).ValidateWithContext(context.Background(), test.value)
+		assertError(t, test.err, err, test.tag)
+		err = Unique(takenEmails).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := Unique(takenEmails).Validate("fail")
+	assert.Equal(t, NewInternalError(errors.New("connection refused")), err)
+
+	u := struct{ Email string }{"fail"}
+	err = ValidateStruct(&u, Field(&u.Email, Unique(takenEmails)))
+	assert.Equal(t, NewInternalError(errors.New("connection refused")), err)
+}
+
+func TestUnique_ContextDone(t *testing.T) {
+	called := false
+	rule := Unique(func(ctx context.Context, value interface{}) (bool, error) {
+		called = true
+		return true, nil
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := ValidateWithContext(ctx, "abc", rule)
+	assert.Equal(t, NewInternalError(context.Canceled), err)
+	assert.False(t, called)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	rule = Unique(func(ctx context.Context, value interface{}) (bool, error) {
+		cancel()
+		<-ctx.Done()
+		return false, ctx.Err()
+	})
+	err = ValidateWithContext(ctx, "abc", rule)
+	assert.Equal(t, NewInternalError(context.Canceled), err)
+}
+
+func TestUniqueRule_Error(t *testing.T) {
+	r := Unique(takenEmails)
+	assert.Equal(t, "is already taken", r.err.Message())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+	assert.Equal(t, err, r.err)
+}
+
+func TestExists(t *testing.T) {
+	categories := func(ctx context.Context, value interface{}) (bool, error) {
+		if value == int64(-1) {
+			return false, errors.New("timeout")
+		}
+		return value == int64(1) || value == int64(2), nil
+	}
+	var id *int64
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", int64(1), ""},
+		{"t2", int64(3), "referenced value does not exist"},
+		{"t3", int64(0), ""},
+		{"t4", id, ""},
+		{"t5", int64(-1), "timeout"},
+	}
+
+	for _, test := range tests {
+		err := Exists(categories).ValidateWithContext(context.Background(), test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	_, ok := Exists(categories).Validate(int64(-1)).(InternalError)
+	assert.True(t, ok)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	err := ValidateWithContext(ctx, int64(1), Exists(categories))
+	assert.Equal(t, NewInternalError(context.DeadlineExceeded), err)
+}
+
+func TestExistsRule_Error(t *testing.T) {
+	r := Exists(takenEmails)
+	assert.Equal(t, "referenced value does not exist", r.err.Message())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+	assert.Equal(t, err, r.err)
+}
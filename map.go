@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 )
 
 var (
@@ -19,6 +20,9 @@ var (
 
 	// ErrKeyUnexpected is the error returned in case of an unexpected key.
 	ErrKeyUnexpected = NewError("validation_key_unexpected", "key not expected")
+
+	// ErrKeysMissing is the error returned by RequireKeys in case of missing keys.
+	ErrKeysMissing = NewError("validation_keys_missing", "missing required keys: {{.keys}}")
 )
 
 type (
@@ -28,11 +32,18 @@ type (
 		allowExtraKeys bool
 	}
 
+	// RequireKeysRule is a validation rule that checks if a map contains the specified keys.
+	RequireKeysRule struct {
+		keys []interface{}
+		err  Error
+	}
+
 	// KeyRules represents a rule set associated with a map key.
 	KeyRules struct {
-		key      interface{}
-		optional bool
-		rules    []Rule
+		key       interface{}
+		optional  bool
+		allValues bool
+		rules     []Rule
 	}
 )
 
@@ -106,16 +117,20 @@ func (r MapRule) ValidateWithContext(ctx context.Context, m interface{}) error {
 			if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
 				return err
 			}
-			errs[getErrorKeyName(kr.key)] = err
+			if !errs.add(getErrorKeyName(kr.key), err) {
+				break
+			}
 		}
 		if !r.allowExtraKeys {
 			delete(extraKeys, kr.key)
 		}
 	}
 
-	if !r.allowExtraKeys {
+	if !r.allowExtraKeys && !errs.Truncated() {
 		for key := range extraKeys {
-			errs[getErrorKeyName(key)] = ErrKeyUnexpected
+			if !errs.add(getErrorKeyName(key), ErrKeyUnexpected) {
+				break
+			}
 		}
 	}
 
@@ -139,6 +154,69 @@ func (r *KeyRules) Optional() *KeyRules {
 	return r
 }
 
+// AllValues configures the rule to validate every value of a key repeated in url.Values, rather than the first one.
+// It only affects ValidateValues.
+func (r *KeyRules) AllValues() *KeyRules {
+	r.allValues = true
+	return r
+}
+
+// RequireKeys returns a validation rule that checks if a map contains all of the given keys.
+// Unlike Map, it only checks the presence of the keys and allows other keys. For example,
+//
+//	err := validation.Validate(payload, validation.RequireKeys("id", "type"))
+//	fmt.Println(err)
+//	// missing required keys: id, type
+//
+// The missing keys are listed in the order they are given, and are available as the "keys" parameter of the error.
+// This rule should only be used for validating maps, or an internal error will be reported.
+// A nil value is considered valid. Use the Required rule to make sure a map value is present.
+func RequireKeys(keys ...interface{}) RequireKeysRule {
+	return RequireKeysRule{keys: keys, err: ErrKeysMissing}
+}
+
+// Error sets the error message for the rule.
+func (r RequireKeysRule) Error(message string) RequireKeysRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r RequireKeysRule) ErrorObject(err Error) RequireKeysRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r RequireKeysRule) Validate(m interface{}) error {
+	value := reflect.ValueOf(m)
+	if value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Map {
+		// must be a map
+		return NewInternalError(ErrNotMap)
+	}
+	if value.IsNil() {
+		// treat a nil map as valid
+		return nil
+	}
+
+	kt := value.Type().Key()
+	var missing []string
+	for _, key := range r.keys {
+		kv := reflect.ValueOf(key)
+		if !kv.IsValid() || !kv.Type().AssignableTo(kt) || !value.MapIndex(kv).IsValid() {
+			missing = append(missing, getErrorKeyName(key))
+		}
+	}
+
+	if len(missing) > 0 {
+		return r.err.SetParams(map[string]interface{}{"keys": strings.Join(missing, ", ")})
+	}
+	return nil
+}
+
 // getErrorKeyName returns the name that should be used to represent the validation error of a map key.
 func getErrorKeyName(key interface{}) string {
 	return fmt.Sprintf("%v", key)
@@ -117,3 +117,45 @@ func TestMapWithContext(t *testing.T) {
 		assert.Equal(t, "Extra: key not expected; Value: the length must be between 5 and 10.", err.Error())
 	}
 }
+
+func TestRequireKeys(t *testing.T) {
+	var nilMap map[string]interface{}
+	m := map[string]interface{}{"id": 1, "name": "abc"}
+	tests := []struct {
+		tag   string
+		keys  []interface{}
+		value interface{}
+		err   string
+	}{
+		{"t1", []interface{}{"id"}, m, ""},
+		{"t2", []interface{}{"id", "name"}, &m, ""},
+		{"t3", []interface{}{"id", "type"}, m, "missing required keys: type"},
+		{"t4", []interface{}{"type", "id", "kind"}, m, "missing required keys: type, kind"},
+		{"t5", []interface{}{"id", "type"}, map[string]interface{}{}, "missing required keys: id, type"},
+		{"t6", []interface{}{"id"}, nilMap, ""},
+		{"t7", []interface{}{1}, m, "missing required keys: 1"},
+		{"t8", []interface{}{1, 2}, map[int]string{1: "a"}, "missing required keys: 2"},
+		{"t9", []interface{}{"id"}, "abc", "only a map can be validated"},
+		{"t10", []interface{}{nil}, m, "missing required keys: <nil>"},
+	}
+
+	for _, test := range tests {
+		err := RequireKeys(test.keys...).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := RequireKeys("id", "type").Validate(map[string]interface{}{})
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "validation_keys_missing", err.(Error).Code())
+		assert.Equal(t, "id, type", err.(Error).Params()["keys"])
+	}
+}
+
+func TestRequireKeysRule_Error(t *testing.T) {
+	r := RequireKeys("id").Error("need {{.keys}}")
+	assert.Equal(t, "need id", r.Validate(map[string]int{}).Error())
+
+	e := NewError("code", "abc")
+	r = r.ErrorObject(e)
+	assert.Equal(t, e, r.err)
+}
@@ -21,6 +21,15 @@ func Match(re *regexp.Regexp) MatchRule {
 	}
 }
 
+// MatchFull returns a validation rule that checks if a whole value matches the specified regular expression.
+// Unlike Match, the pattern is implicitly anchored at both ends by wrapping it as `\A(?:pattern)\z`,
+// so a value containing a matching substring is not considered valid.
+// MatchFull panics if the pattern cannot be compiled, like regexp.MustCompile.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func MatchFull(pattern string) MatchRule {
+	return Match(regexp.MustCompile(`\A(?:` + pattern + `)\z`))
+}
+
 // MatchRule is a validation rule that checks if a value matches the specified regular expression.
 type MatchRule struct {
 	re  *regexp.Regexp
@@ -36,6 +36,31 @@ func TestMatch(t *testing.T) {
 	}
 }
 
+func TestMatchFull(t *testing.T) {
+	tests := []struct {
+		tag     string
+		pattern string
+		value   interface{}
+		err     string
+	}{
+		{"t1", "[a-z]+", "abc", ""},
+		{"t2", "[a-z]+", "", ""},
+		{"t3", "[a-z]+", "abc123", "must be in a valid format"},
+		{"t4", "[a-z]+", "123abc", "must be in a valid format"},
+		{"t5", "abc|def", "abcdef", "must be in a valid format"},
+		{"t6", "abc|def", "def", ""},
+		{"t7", "[a-z]+", "abc\n", "must be in a valid format"},
+		{"t8", "[a-z]+", []byte("abc"), ""},
+	}
+
+	for _, test := range tests {
+		err := MatchFull(test.pattern).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	assert.Panics(t, func() { MatchFull("[a-z") })
+}
+
 func Test_MatchRule_Error(t *testing.T) {
 	r := Match(regexp.MustCompile("[a-z]+"))
 	assert.Equal(t, "must be in a valid format", r.Validate("13").Error())
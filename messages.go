@@ -0,0 +1,69 @@
+package validation
+
+import "context"
+
+type messagesKey struct{}
+
+// WithMessages returns a copy of the context carrying the given error messages indexed by error codes.
+// When validating with the returned context, the message of a validation error whose code is found in
+// the map is replaced with the corresponding message, while other errors keep their default messages.
+// For example,
+//
+//	ctx = validation.WithMessages(ctx, map[string]string{
+//	    "validation_required": "This field is mandatory",
+//	    "validation_length_out_of_range": "Please use {{.min}} to {{.max}} characters",
+//	})
+//	err := validation.ValidateWithContext(ctx, name, validation.Required, validation.Length(2, 50))
+//
+// The messages may use the parameters of the errors just like the default messages.
+// Messages of an enclosing context are overridden by those with the same codes, and kept otherwise.
+func WithMessages(ctx context.Context, messages map[string]string) context.Context {
+	if parent := messagesFromContext(ctx); len(parent) > 0 {
+		merged := make(map[string]string, len(parent)+len(messages))
+		for code, message := range parent {
+			merged[code] = message
+		}
+		for code, message := range messages {
+			merged[code] = message
+		}
+		messages = merged
+	}
+	return context.WithValue(ctx, messagesKey{}, messages)
+}
+
+// messagesFromContext returns the error messages carried by the context, if any.
+func messagesFromContext(ctx context.Context) map[string]string {
+	if ctx == nil {
+		return nil
+	}
+	messages, _ := ctx.Value(messagesKey{}).(map[string]string)
+	return messages
+}
+
+// applyMessages replaces the messages of the validation errors with those carried by the context.
+func applyMessages(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	messages := messagesFromContext(ctx)
+	if len(messages) == 0 {
+		return err
+	}
+	return replaceMessages(err, messages)
+}
+
+func replaceMessages(err error, messages map[string]string) error {
+	switch e := err.(type) {
+	case Errors:
+		errs := make(Errors, len(e))
+		for key, ee := range e {
+			errs[key] = replaceMessages(ee, messages)
+		}
+		return errs
+	case Error:
+		if message, ok := messages[e.Code()]; ok {
+			return e.SetMessage(message)
+		}
+	}
+	return err
+}
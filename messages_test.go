@@ -0,0 +1,70 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithMessages(t *testing.T) {
+	ctx := WithMessages(context.Background(), map[string]string{
+		"validation_required":            "This field is mandatory",
+		"validation_length_out_of_range": "use {{.min}} to {{.max}} characters",
+	})
+
+	err := ValidateWithContext(ctx, "", Required)
+	assertError(t, "This field is mandatory", err, "t1")
+	err = ValidateWithContext(ctx, "a", Length(2, 5))
+	assertError(t, "use 2 to 5 characters", err, "t2")
+	err = ValidateWithContext(ctx, "abc", In("xyz"))
+	assertError(t, "must be a valid value", err, "t3")
+	err = ValidateWithContext(ctx, "abc", By(func(interface{}) error { return errors.New("abc") }))
+	assertError(t, "abc", err, "t4")
+
+	// default messages are used without the context messages
+	err = ValidateWithContext(context.Background(), "", Required)
+	assertError(t, "cannot be blank", err, "t5")
+	err = Validate("", Required)
+	assertError(t, "cannot be blank", err, "t6")
+	assert.Equal(t, "cannot be blank", ErrRequired.Message(), "t7")
+
+	// the code of the error is kept
+	err = ValidateWithContext(ctx, "", Required)
+	if assert.Implements(t, (*Error)(nil), err, "t8") {
+		assert.Equal(t, "validation_required", err.(Error).Code(), "t8")
+	}
+
+	// nested errors
+	m := map[string]interface{}{"name": "", "tags": []string{"a", ""}}
+	err = ValidateWithContext(ctx, m, Map(
+		Key("name", Required),
+		Key("tags", Each(Required)),
+	))
+	assertError(t, "name: This field is mandatory; tags: (1: This field is mandatory.).", err, "t9")
+
+	// struct fields
+	s := struct {
+		Name string
+		Code string
+	}{Code: "a"}
+	err = ValidateStructWithContext(ctx, &s,
+		Field(&s.Name, Required),
+		Field(&s.Code, Length(2, 5)),
+	)
+	assertError(t, "Code: use 2 to 5 characters; Name: This field is mandatory.", err, "t10")
+
+	// nested contexts merge the messages
+	ctx2 := WithMessages(ctx, map[string]string{"validation_required": "required"})
+	err = ValidateWithContext(ctx2, "", Required)
+	assertError(t, "required", err, "t11")
+	err = ValidateWithContext(ctx2, "a", Length(2, 5))
+	assertError(t, "use 2 to 5 characters", err, "t12")
+	err = ValidateWithContext(ctx, "", Required)
+	assertError(t, "This field is mandatory", err, "t13")
+
+	// internal errors are kept
+	err = ValidateStructWithContext(ctx, s)
+	assert.Equal(t, NewInternalError(ErrStructPointer), err, "t14")
+}
@@ -38,7 +38,10 @@ const (
 // Min returns a validation rule that checks if a value is greater or equal than the specified value.
 // By calling Exclusive, the rule will check if the value is strictly greater than the specified value.
 // Note that the value being checked and the threshold value must be of the same type.
-// Only int, uint, float and time.Time types are supported.
+// Only int, uint, float and time.Time types are supported. Pointers, such as *time.Time, are dereferenced
+// for both the value and the threshold, and a nil threshold means there is no limit.
+// If the value is of a custom numeric type implementing fmt.Stringer, the threshold in the error message
+// will be rendered using the String() method of that type.
 // An empty value is considered valid. Please use the Required rule to make sure a value is not empty.
 func Min(min interface{}) ThresholdRule {
 	return ThresholdRule{
@@ -52,7 +55,10 @@ func Min(min interface{}) ThresholdRule {
 // Max returns a validation rule that checks if a value is less or equal than the specified value.
 // By calling Exclusive, the rule will check if the value is strictly less than the specified value.
 // Note that the value being checked and the threshold value must be of the same type.
-// Only int, uint, float and time.Time types are supported.
+// Only int, uint, float and time.Time types are supported. Pointers, such as *time.Time, are dereferenced
+// for both the value and the threshold, and a nil threshold means there is no limit.
+// If the value is of a custom numeric type implementing fmt.Stringer, the threshold in the error message
+// will be rendered using the String() method of that type.
 // An empty value is considered valid. Please use the Required rule to make sure a value is not empty.
 func Max(max interface{}) ThresholdRule {
 	return ThresholdRule{
@@ -81,7 +87,17 @@ func (r ThresholdRule) Validate(value interface{}) error {
 		return nil
 	}
 
-	rv := reflect.ValueOf(r.threshold)
+	threshold := r.threshold
+	rv := reflect.ValueOf(threshold)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			// a nil threshold imposes no limit
+			return nil
+		}
+		rv = rv.Elem()
+		threshold = rv.Interface()
+	}
+
 	switch rv.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		v, err := ToInt(value)
@@ -111,7 +127,7 @@ func (r ThresholdRule) Validate(value interface{}) error {
 		}
 
 	case reflect.Struct:
-		t, ok := r.threshold.(time.Time)
+		t, ok := threshold.(time.Time)
 		if !ok {
 			return fmt.Errorf("type not supported: %v", rv.Type())
 		}
@@ -127,7 +143,7 @@ func (r ThresholdRule) Validate(value interface{}) error {
 		return fmt.Errorf("type not supported: %v", rv.Type())
 	}
 
-	return r.err.SetParams(map[string]interface{}{"threshold": r.threshold})
+	return r.err.SetParams(map[string]interface{}{"threshold": stringerParam(threshold, value)})
 }
 
 // Error sets the error message for the rule.
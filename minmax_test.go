@@ -5,6 +5,7 @@
 package validation
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -137,6 +138,56 @@ func TestMaxError(t *testing.T) {
 	assert.Equal(t, "123", r.err.Message())
 }
 
+type Celsius float64
+
+func (c Celsius) String() string {
+	return fmt.Sprintf("%.2f°C", float64(c))
+}
+
+func TestThresholdRule_Stringer(t *testing.T) {
+	assert.Nil(t, Min(-273.15).Validate(Celsius(20)))
+	assert.EqualError(t, Min(-273.15).Validate(Celsius(-300)), "must be no less than -273.15°C")
+	assert.EqualError(t, Max(Celsius(100)).Exclusive().Validate(Celsius(100)), "must be less than 100.00°C")
+	assert.EqualError(t, Max(100).Validate(Level(101)), "must be no greater than 100")
+}
+
+func TestThresholdRule_TimePointers(t *testing.T) {
+	minDate := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	before, after := minDate.Add(-time.Hour), minDate.Add(time.Hour)
+	var nilDate *time.Time
+	zero := time.Time{}
+
+	s := struct {
+		OptionalDate *time.Time
+	}{}
+	tests := []struct {
+		tag   string
+		value *time.Time
+		rule  ThresholdRule
+		err   string
+	}{
+		{"t1", nil, Min(minDate), ""},
+		{"t2", &after, Min(minDate), ""},
+		{"t3", &before, Min(minDate), "OptionalDate: must be no less than 2020-01-01 00:00:00 +0000 UTC."},
+		{"t4", &zero, Min(minDate), ""},
+		{"t5", &before, Min(&minDate), "OptionalDate: must be no less than 2020-01-01 00:00:00 +0000 UTC."},
+		{"t6", &after, Max(&minDate).Exclusive(), "OptionalDate: must be less than 2020-01-01 00:00:00 +0000 UTC."},
+		{"t7", nil, Max(&minDate), ""},
+		{"t8", &after, Max(nilDate), ""},
+		{"t9", &minDate, Max(&minDate), ""},
+	}
+
+	for _, test := range tests {
+		s.OptionalDate = test.value
+		err := ValidateStruct(&s, Field(&s.OptionalDate, test.rule))
+		assertError(t, test.err, err, test.tag)
+	}
+
+	ten := 10
+	assert.EqualError(t, Min(&ten).Validate(5), "must be no less than 10")
+	assert.Nil(t, Min(&ten).Validate(&ten))
+}
+
 func TestThresholdRule_ErrorObject(t *testing.T) {
 	r := Max(10)
 	err := NewError("code", "abc")
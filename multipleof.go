@@ -61,5 +61,5 @@ func (r MultipleOfRule) Validate(value interface{}) error {
 		return fmt.Errorf("type not supported: %v", rv.Type())
 	}
 
-	return r.err.SetParams(map[string]interface{}{"base": r.base})
+	return r.err.SetParams(map[string]interface{}{"base": stringerParam(r.base, value)})
 }
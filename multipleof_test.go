@@ -24,6 +24,9 @@ func TestMultipleOf(t *testing.T) {
 	assert.Equal(t, nil, r3.Validate(uint(20)))
 	assert.Equal(t, "cannot convert float32 to uint64", r3.Validate(float32(20)).Error())
 
+	r4 := MultipleOf(5)
+	assert.Equal(t, "must be multiple of 5", r4.Validate(Level(7)).Error())
+	assert.Equal(t, nil, r4.Validate(Level(10)))
 }
 
 func Test_MultipleOf_Error(t *testing.T) {
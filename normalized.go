@@ -0,0 +1,73 @@
+package validation
+
+import (
+	"fmt"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// ErrUnicodeNormalized is the error that returns when a string is not in the required Unicode normalization form.
+var ErrUnicodeNormalized = NewError("validation_unicode_normalized", "text must be in {{.form}} normalized form")
+
+// UnicodeNormalizedRule is a validation rule that checks if a string is in a Unicode normalization form.
+type UnicodeNormalizedRule struct {
+	form norm.Form
+	err  Error
+}
+
+// UnicodeNormalized returns a validation rule that checks if a string is already in the given Unicode
+// normalization form, such as norm.NFC. This helps to prevent values that look the same but are encoded
+// differently, e.g. duplicate-looking usernames. The name of the form is available as the "form" parameter
+// of the error.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func UnicodeNormalized(form norm.Form) UnicodeNormalizedRule {
+	return UnicodeNormalizedRule{
+		form: form,
+		err:  ErrUnicodeNormalized.SetParams(map[string]interface{}{"form": normFormName(form)}),
+	}
+}
+
+// Error sets the error message for the rule.
+func (r UnicodeNormalizedRule) Error(message string) UnicodeNormalizedRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r UnicodeNormalizedRule) ErrorObject(err Error) UnicodeNormalizedRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r UnicodeNormalizedRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	if r.form.IsNormalString(str) {
+		return nil
+	}
+	return r.err
+}
+
+// normFormName returns the name of a Unicode normalization form.
+func normFormName(form norm.Form) string {
+	switch form {
+	case norm.NFC:
+		return "NFC"
+	case norm.NFD:
+		return "NFD"
+	case norm.NFKC:
+		return "NFKC"
+	case norm.NFKD:
+		return "NFKD"
+	}
+	return fmt.Sprintf("Form(%d)", int(form))
+}
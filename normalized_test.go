@@ -0,0 +1,53 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestUnicodeNormalized(t *testing.T) {
+	var s *string
+	composed, decomposed := "caf\u00e9", "cafe\u0301"
+	tests := []struct {
+		tag   string
+		form  norm.Form
+		value interface{}
+		err   string
+	}{
+		{"t1", norm.NFC, "", ""},
+		{"t2", norm.NFC, "abc", ""},
+		{"t3", norm.NFC, composed, ""},
+		{"t4", norm.NFC, decomposed, "text must be in NFC normalized form"},
+		{"t5", norm.NFD, decomposed, ""},
+		{"t6", norm.NFD, composed, "text must be in NFD normalized form"},
+		{"t7", norm.NFKC, "\ufb01", "text must be in NFKC normalized form"},
+		{"t8", norm.NFKD, "fi", ""},
+		{"t9", norm.NFC, []byte(decomposed), "text must be in NFC normalized form"},
+		{"t10", norm.NFC, &composed, ""},
+		{"t11", norm.NFC, s, ""},
+		{"t12", norm.NFC, 1, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		err := UnicodeNormalized(test.form).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func Test_UnicodeNormalizedRule_Error(t *testing.T) {
+	r := UnicodeNormalized(norm.NFC).Error("must use {{.form}}")
+	assert.Equal(t, "must use NFC", r.Validate("e\u0301").Error())
+	assert.Equal(t, "Form(9)", normFormName(norm.Form(9)))
+}
+
+func TestUnicodeNormalizedRule_ErrorObject(t *testing.T) {
+	r := UnicodeNormalized(norm.NFC)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
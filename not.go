@@ -0,0 +1,72 @@
+package validation
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotInvalid is the error that returns when a value satisfies a negated rule.
+var ErrNotInvalid = NewError("validation_not_invalid", "must not satisfy the rule")
+
+// NotRule is a validation rule that inverts the result of another rule.
+type NotRule struct {
+	rule Rule
+	err  Error
+}
+
+// Not returns a validation rule that passes if the given rule fails, and fails if the given rule passes.
+// Since the default message cannot describe the inverted meaning, it should be set with Error, e.g.
+//
+//	validation.Not(is.EmailFormat).Error("must not be an email address")
+//
+// Only the validation errors (Error or Errors) of the given rule are inverted. Other errors, such as internal
+// errors or the plain errors reporting a value of an unsupported type, are returned as is.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Not(rule Rule) NotRule {
+	return NotRule{
+		rule: rule,
+		err:  ErrNotInvalid,
+	}
+}
+
+// Error sets the error message for the rule.
+func (r NotRule) Error(message string) NotRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r NotRule) ErrorObject(err Error) NotRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r NotRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(context.Background(), value)
+}
+
+// ValidateWithContext checks if the given value is valid or not.
+// The context is passed to the negated rule if it implements RuleWithContext.
+func (r NotRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	if isEmptyValue(value) {
+		return nil
+	}
+
+	var err error
+	if rc, ok := r.rule.(RuleWithContext); ok && ctx != nil {
+		err = rc.ValidateWithContext(ctx, value)
+	} else {
+		err = r.rule.Validate(value)
+	}
+
+	if err == nil {
+		return r.err
+	}
+	var e Error
+	var es Errors
+	if errors.As(err, &e) || errors.As(err, &es) {
+		return nil
+	}
+	return err
+}
@@ -4,13 +4,12 @@
 
 package validation
 
-import "reflect"
-
 // ErrNotInInvalid is the error that returns when a value is in a list.
 var ErrNotInInvalid = NewError("validation_not_in_invalid", "must not be in list")
 
 // NotIn returns a validation rule that checks if a value is absent from the given list of values.
-// Like with In(), reflect.DeepEqual() will be used to determine if two values are equal.
+// Like with In(), reflect.DeepEqual() will be used to determine if two values are equal,
+// and numeric values are compared by value regardless of their types.
 // An empty value is considered valid. Use the Required rule to make sure a value is not empty.
 func NotIn[T any](values ...T) NotInRule[T] {
 	return NotInRule[T]{
@@ -33,7 +32,7 @@ func (r NotInRule[T]) Validate(value interface{}) error {
 	}
 
 	for _, e := range r.elements {
-		if reflect.DeepEqual(e, value) {
+		if equalValues(e, value) {
 			return r.err
 		}
 	}
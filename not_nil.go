@@ -10,6 +10,8 @@ var ErrNotNilRequired = NewError("validation_not_nil_required", "is required")
 // NotNil is a validation rule that checks if a value is not nil.
 // NotNil only handles types including interface, pointer, slice, and map.
 // All other types are considered valid.
+// Unlike Required, NotNil treats a non-nil empty slice or map as present, so it can be used
+// to require that a collection is provided while still allowing it to be empty.
 var NotNil = notNilRule{}
 
 type notNilRule struct {
@@ -41,6 +41,30 @@ func TestNotNil(t *testing.T) {
 	}
 }
 
+func TestNotNil_CollectionPresence(t *testing.T) {
+	var nilSlice []string
+	var nilMap map[string]int
+	tests := []struct {
+		tag         string
+		value       interface{}
+		notNilErr   string
+		requiredErr string
+	}{
+		{"t1", nilSlice, "is required", "cannot be blank"},
+		{"t2", []string{}, "", "cannot be blank"},
+		{"t3", []string{"a"}, "", ""},
+		{"t4", &nilSlice, "is required", "cannot be blank"},
+		{"t5", nilMap, "is required", "cannot be blank"},
+		{"t6", map[string]int{}, "", "cannot be blank"},
+		{"t7", map[string]int{"a": 1}, "", ""},
+	}
+
+	for _, test := range tests {
+		assertError(t, test.notNilErr, NotNil.Validate(test.value), test.tag)
+		assertError(t, test.requiredErr, Required.Validate(test.value), test.tag)
+	}
+}
+
 func Test_notNilRule_Error(t *testing.T) {
 	r := NotNil
 	assert.Equal(t, "is required", r.Validate(nil).Error())
@@ -0,0 +1,68 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNot(t *testing.T) {
+	var s *string
+	internal := NewInternalError(errors.New("abc"))
+	tests := []struct {
+		tag   string
+		rule  Rule
+		value interface{}
+		err   string
+	}{
+		{"t1", In("a", "b"), "a", "must not satisfy the rule"},
+		{"t2", In("a", "b"), "c", ""},
+		{"t3", Length(1, 3), "abcd", ""},
+		{"t4", Length(1, 3), "abc", "must not satisfy the rule"},
+		{"t5", By(func(interface{}) error { return errors.New("xyz") }), "abc", "xyz"},
+		{"t6", By(func(interface{}) error { return internal }), "abc", "abc"},
+		{"t7", In("a"), "", ""},
+		{"t8", In("a"), s, ""},
+		{"t9", Not(In("a")), "a", ""},
+		{"t10", Not(In("a")), "b", "must not satisfy the rule"},
+		{"t11", Length(1, 3), 123, "cannot get the length of int"},
+		{"t12", Each(Required), []string{""}, ""},
+	}
+
+	for _, test := range tests {
+		err := Not(test.rule).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := Not(In("admin")).Error("must not be a reserved name").Validate("admin")
+	assert.EqualError(t, err, "must not be a reserved name")
+}
+
+func TestNot_ValidateWithContext(t *testing.T) {
+	type key struct{}
+	rule := WithContext(func(ctx context.Context, value interface{}) error {
+		if ctx.Value(key{}) == value {
+			return nil
+		}
+		return NewError("unexpected", "unexpected value")
+	})
+	ctx := context.WithValue(context.Background(), key{}, "abc")
+
+	err := ValidateWithContext(ctx, "abc", Not(rule))
+	assert.EqualError(t, err, "must not satisfy the rule")
+	err = ValidateWithContext(ctx, "xyz", Not(rule))
+	assert.Nil(t, err)
+}
+
+func TestNotRule_Error(t *testing.T) {
+	r := Not(In("a"))
+	assert.Equal(t, "must not satisfy the rule", r.err.Message())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+	assert.Equal(t, err, r.err)
+}
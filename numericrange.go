@@ -0,0 +1,79 @@
+package validation
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrNotNumeric is the error that returns when a string is not a number.
+	ErrNotNumeric = NewError("validation_not_numeric", "must be a number")
+	// ErrNumericOutOfRange is the error that returns when a numeric string is out of the specified range.
+	ErrNumericOutOfRange = NewError("validation_numeric_out_of_range", "must be a number between {{.min}} and {{.max}}")
+)
+
+// NumericRangeRule is a validation rule that checks if a string is a number within the specified range.
+type NumericRangeRule struct {
+	min, max      float64
+	err, rangeErr Error
+}
+
+// NumericRange returns a validation rule that checks if a string, such as a value of an HTML form, is a decimal
+// number between min and max, inclusive. This avoids a separate parsing step before checking the bounds.
+// Leading and trailing white space is ignored, and NaN and infinities are not considered numbers.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func NumericRange(min, max float64) NumericRangeRule {
+	return NumericRangeRule{
+		min:      min,
+		max:      max,
+		err:      ErrNotNumeric,
+		rangeErr: ErrNumericOutOfRange.SetParams(map[string]interface{}{"min": min, "max": max}),
+	}
+}
+
+// Error sets the error message that is used when the value being validated is not a number.
+func (r NumericRangeRule) Error(message string) NumericRangeRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct that is used when the value being validated is not a number.
+func (r NumericRangeRule) ErrorObject(err Error) NumericRangeRule {
+	r.err = err
+	return r
+}
+
+// RangeError sets the error message that is used when the value being validated is out of the specified range.
+func (r NumericRangeRule) RangeError(message string) NumericRangeRule {
+	r.rangeErr = r.rangeErr.SetMessage(message)
+	return r
+}
+
+// RangeErrorObject sets the error struct that is used when the value being validated is out of the specified range.
+func (r NumericRangeRule) RangeErrorObject(err Error) NumericRangeRule {
+	r.rangeErr = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r NumericRangeRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	n, err := strconv.ParseFloat(strings.TrimSpace(str), 64)
+	if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+		return r.err
+	}
+	if n < r.min || n > r.max {
+		return r.rangeErr
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumericRange(t *testing.T) {
+	var s *string
+	tests := []struct {
+		tag   string
+		min   float64
+		max   float64
+		value interface{}
+		err   string
+	}{
+		{"t1", 1, 100, "1", ""},
+		{"t2", 1, 100, "100", ""},
+		{"t3", 1, 100, "50.5", ""},
+		{"t4", 1, 100, " 42 ", ""},
+		{"t5", 1, 100, "0", "must be a number between 1 and 100"},
+		{"t6", 1, 100, "100.01", "must be a number between 1 and 100"},
+		{"t7", 1, 100, "-5", "must be a number between 1 and 100"},
+		{"t8", 1, 100, "abc", "must be a number"},
+		{"t9", 1, 100, "1,5", "must be a number"},
+		{"t10", 1, 100, "NaN", "must be a number"},
+		{"t11", 1, 100, "Inf", "must be a number"},
+		{"t12", -1.5, 1.5, "-1.5", ""},
+		{"t13", -1.5, 1.5, "1e1", "must be a number between -1.5 and 1.5"},
+		{"t14", 1, 100, "", ""},
+		{"t15", 1, 100, s, ""},
+		{"t16", 1, 100, []byte("7"), ""},
+		{"t17", 1, 100, 7, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		err := NumericRange(test.min, test.max).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func Test_NumericRangeRule_Error(t *testing.T) {
+	r := NumericRange(1, 10)
+	assert.Equal(t, "must be a number", r.err.Message())
+	assert.Equal(t, "must be a number between {{.min}} and {{.max}}", r.rangeErr.Message())
+	r = r.Error("123")
+	r = r.RangeError("456")
+	assert.Equal(t, "123", r.err.Message())
+	assert.Equal(t, "456", r.rangeErr.Message())
+}
+
+func TestNumericRangeRule_ErrorObject(t *testing.T) {
+	r := NumericRange(1, 10)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+
+	r = r.RangeErrorObject(err)
+	assert.Equal(t, err, r.rangeErr)
+}
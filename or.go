@@ -0,0 +1,75 @@
+package validation
+
+import (
+	"context"
+	"strings"
+)
+
+// ErrOrInvalid is the error that returns when a value satisfies none of the rules combined by Or.
+// The messages of the errors returned by the rules, joined by " or ", are available as the "message" parameter.
+var ErrOrInvalid = NewError("validation_or_invalid", "{{.message}}")
+
+// OrRule is a validation rule that passes if any of the given rules passes.
+type OrRule struct {
+	rules []Rule
+	err   Error
+}
+
+// Or returns a validation rule that passes if any of the given rules passes. For example, the following rule
+// accepts either an email address or a phone number:
+//
+//	validation.Or(is.EmailFormat, validation.Phone("US"))
+//
+// The rules are tried in order until one of them passes. If all of them fail, the error message lists the messages
+// of their errors, e.g. "must be a valid email address or must be a valid phone number". An internal error returned
+// by a rule stops the validation and is returned as is.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Or(rules ...Rule) OrRule {
+	return OrRule{
+		rules: rules,
+		err:   ErrOrInvalid,
+	}
+}
+
+// Error sets the error message for the rule.
+func (r OrRule) Error(message string) OrRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r OrRule) ErrorObject(err Error) OrRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r OrRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(context.Background(), value)
+}
+
+// ValidateWithContext checks if the given value is valid or not.
+// The context is passed to the rules that implement RuleWithContext.
+func (r OrRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	if len(r.rules) == 0 || isEmptyValue(value) {
+		return nil
+	}
+
+	messages := make([]string, 0, len(r.rules))
+	for _, rule := range r.rules {
+		var err error
+		if rc, ok := rule.(RuleWithContext); ok && ctx != nil {
+			err = rc.ValidateWithContext(ctx, value)
+		} else {
+			err = rule.Validate(value)
+		}
+		if err == nil {
+			return nil
+		}
+		if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
+			return err
+		}
+		messages = append(messages, err.Error())
+	}
+	return r.err.SetParams(map[string]interface{}{"message": strings.Join(messages, " or ")})
+}
@@ -0,0 +1,68 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOr(t *testing.T) {
+	var s *string
+	email := Match(regexp.MustCompile(`^\S+@\S+$`)).Error("must be a valid email address")
+	internal := By(func(interface{}) error { return NewInternalError(errors.New("abc")) })
+	tests := []struct {
+		tag   string
+		rules []Rule
+		value interface{}
+		err   string
+	}{
+		{"t1", []Rule{email, Phone("US")}, "john@example.com", ""},
+		{"t2", []Rule{email, Phone("US")}, "+1 555-0100", ""},
+		{"t3", []Rule{email, Phone("US")}, "john", "must be a valid email address or must be a valid phone number"},
+		{"t4", []Rule{In("a"), In("b"), Length(5, 0)}, "c", "must be a valid value or must be a valid value or the length must be no less than 5"},
+		{"t5", []Rule{In("a"), internal}, "c", "abc"},
+		{"t6", []Rule{In("c"), internal}, "c", ""},
+		{"t7", []Rule{email, Phone("US")}, "", ""},
+		{"t8", []Rule{email, Phone("US")}, s, ""},
+		{"t9", []Rule{}, "abc", ""},
+		{"t10", []Rule{Or(In("a"), In("b")), In("c")}, "b", ""},
+	}
+
+	for _, test := range tests {
+		err := Or(test.rules...).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := Or(email, Phone("US")).Error("must be an email address or a phone number").Validate("john")
+	assert.EqualError(t, err, "must be an email address or a phone number")
+}
+
+func TestOr_ValidateWithContext(t *testing.T) {
+	type key struct{}
+	rule := WithContext(func(ctx context.Context, value interface{}) error {
+		if ctx.Value(key{}) == value {
+			return nil
+		}
+		return errors.New("unexpected value")
+	})
+	ctx := context.WithValue(context.Background(), key{}, "abc")
+
+	err := ValidateWithContext(ctx, "abc", Or(In("x"), rule))
+	assert.Nil(t, err)
+	err = ValidateWithContext(ctx, "xyz", Or(In("x"), rule))
+	assert.EqualError(t, err, "must be a valid value or unexpected value")
+}
+
+func TestOrRule_Error(t *testing.T) {
+	r := Or(In("a"))
+	assert.Equal(t, "{{.message}}", r.err.Message())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+	assert.Equal(t, err, r.err)
+}
@@ -0,0 +1,180 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+var (
+	// ErrNotAscending is the error that returns when the elements of a slice are not in ascending order.
+	ErrNotAscending = NewError("validation_not_ascending", "values must be in ascending order")
+	// ErrNotDescending is the error that returns when the elements of a slice are not in descending order.
+	ErrNotDescending = NewError("validation_not_descending", "values must be in descending order")
+	// ErrNotSortedSet is the error that returns when the elements of a slice are not strictly ascending.
+	ErrNotSortedSet = NewError("validation_not_sorted_set", "must be a sorted set of unique values")
+)
+
+// Ascending is a validation rule that checks if the elements of a slice or array are in non-decreasing order.
+// The elements can be integers, floats, strings or time.Time values. If the order is broken,
+// the index of the first element that is out of order is available as the "index" parameter of the error.
+// An empty value is considered valid.
+var Ascending = OrderRule{}
+
+// Descending is a validation rule that checks if the elements of a slice or array are in non-increasing order.
+// Please refer to Ascending for the supported element types.
+var Descending = OrderRule{descending: true}
+
+// SortedSet is a validation rule that checks if the elements of a slice or array are in strictly ascending order,
+// which means they are sorted and free of duplicates. This is useful for validating canonicalized lists, such as
+// sorted ID lists. Please refer to Ascending for the supported element types and the error parameters.
+var SortedSet = OrderRule{strict: true}
+
+// OrderedBy returns a validation rule that checks if the elements of a slice or array of structs are ordered
+// by the named field, in ascending (non-decreasing) or descending (non-increasing) order. For example,
+//
+//	type Event struct {
+//	    Time time.Time
+//	    Name string
+//	}
+//	err := validation.Validate(events, validation.OrderedBy("Time", true))
+//
+// The field can be of any type supported by Ascending, and the elements can be pointers to structs.
+// If the order is broken, the index of the first element that is out of order is available as the "index"
+// parameter of the error. An empty value is considered valid.
+func OrderedBy(fieldName string, ascending bool) OrderRule {
+	return OrderRule{field: fieldName, descending: !ascending}
+}
+
+// OrderRule is a validation rule that checks if the elements of a slice or array are ordered.
+type OrderRule struct {
+	field      string
+	descending bool
+	strict     bool
+	err        Error
+}
+
+// Error sets the error message for the rule.
+func (r OrderRule) Error(message string) OrderRule {
+	r.err = r.defaultError().SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r OrderRule) ErrorObject(err Error) OrderRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r OrderRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return errors.New("must be a slice or an array")
+	}
+
+	prev, err := r.element(v, 0)
+	if err != nil {
+		return err
+	}
+	for i := 1; i < v.Len(); i++ {
+		cur, err := r.element(v, i)
+		if err != nil {
+			return err
+		}
+		c, err := compareValues(prev, cur)
+		if err != nil {
+			return err
+		}
+		prev = cur
+		if !r.descending && c > 0 || r.descending && c < 0 || r.strict && c == 0 {
+			return r.defaultError().SetParams(map[string]interface{}{"index": i})
+		}
+	}
+
+	return nil
+}
+
+// element returns the i-th element of the slice, or its field that the elements are ordered by.
+func (r OrderRule) element(v reflect.Value, i int) (reflect.Value, error) {
+	ev := v.Index(i)
+	if r.field == "" {
+		return ev, nil
+	}
+	for ev.Kind() == reflect.Ptr || ev.Kind() == reflect.Interface {
+		if ev.IsNil() {
+			return reflect.Value{}, NewInternalError(fmt.Errorf("element #%v must not be nil", i))
+		}
+		ev = ev.Elem()
+	}
+	if ev.Kind() != reflect.Struct {
+		return reflect.Value{}, NewInternalError(fmt.Errorf("element #%v must be a struct", i))
+	}
+	fv := ev.FieldByName(r.field)
+	if !fv.IsValid() || !fv.CanInterface() {
+		return reflect.Value{}, NewInternalError(fmt.Errorf("field %v cannot be found in %v", r.field, ev.Type()))
+	}
+	return fv, nil
+}
+
+// defaultError returns the error set for the rule, or the pre-defined error of its order.
+func (r OrderRule) defaultError() Error {
+	if r.err != nil {
+		return r.err
+	}
+	if r.strict {
+		return ErrNotSortedSet
+	}
+	if r.descending {
+		return ErrNotDescending
+	}
+	return ErrNotAscending
+}
+
+// compareValues compares two values of the same ordered type.
+// It returns -1, 0 or 1 if a is less than, equal to or greater than b, respectively.
+func compareValues(a, b reflect.Value) (int, error) {
+	for a.Kind() == reflect.Ptr || a.Kind() == reflect.Interface {
+		a = a.Elem()
+	}
+	for b.Kind() == reflect.Ptr || b.Kind() == reflect.Interface {
+		b = b.Elem()
+	}
+	if !a.IsValid() || !b.IsValid() || a.Kind() != b.Kind() {
+		return 0, errors.New("cannot compare values of different types")
+	}
+
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return compareOrdered(a.Int(), b.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return compareOrdered(a.Uint(), b.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return compareOrdered(a.Float(), b.Float()), nil
+	case reflect.String:
+		return compareOrdered(a.String(), b.String()), nil
+	case reflect.Struct:
+		if ta, ok := a.Interface().(time.Time); ok {
+			if tb, ok := b.Interface().(time.Time); ok {
+				return ta.Compare(tb), nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("type not supported: %v", a.Type())
+}
+
+func compareOrdered[T int64 | uint64 | float64 | string](a, b T) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
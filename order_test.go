@@ -0,0 +1,164 @@
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAscending(t *testing.T) {
+	t1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Hour)
+	var s *[]int
+	one, two := 1, 2
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", []int{1, 2, 2, 3}, ""},
+		{"t2", []int{1, 3, 2}, "values must be in ascending order"},
+		{"t3", []float64{0.5, 1.5, 1.5}, ""},
+		{"t4", []float64{1.5, 0.5}, "values must be in ascending order"},
+		{"t5", []time.Time{t1, t1, t2}, ""},
+		{"t6", []time.Time{t2, t1}, "values must be in ascending order"},
+		{"t7", [3]string{"a", "b", "c"}, ""},
+		{"t8", []uint{2, 1}, "values must be in ascending order"},
+		{"t9", []*int{&one, &two}, ""},
+		{"t10", []int{5}, ""},
+		{"t11", []int{}, ""},
+		{"t12", nil, ""},
+		{"t13", s, ""},
+		{"t14", 1, "must be a slice or an array"},
+		{"t15", []interface{}{1, "a"}, "cannot compare values of different types"},
+		{"t16", []struct{}{{}, {}}, "type not supported: struct {}"},
+	}
+
+	for _, test := range tests {
+		err := Ascending.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestDescending(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", []int{3, 2, 2, 1}, ""},
+		{"t2", []int{3, 1, 2}, "values must be in descending order"},
+		{"t3", []string{"b", "a"}, ""},
+		{"t4", []int{}, ""},
+	}
+
+	for _, test := range tests {
+		err := Descending.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestSortedSet(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", []int{1, 2, 3}, ""},
+		{"t2", []int{1, 2, 2, 3}, "must be a sorted set of unique values"},
+		{"t3", []int{1, 3, 2}, "must be a sorted set of unique values"},
+		{"t4", []string{"a", "b", "c"}, ""},
+		{"t5", []string{"b", "a"}, "must be a sorted set of unique values"},
+		{"t6", []int{5}, ""},
+		{"t7", []int{}, ""},
+		{"t8", nil, ""},
+		{"t9", "abc", "must be a slice or an array"},
+	}
+
+	for _, test := range tests {
+		err := SortedSet.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := SortedSet.Validate([]int{1, 2, 3, 3})
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "validation_not_sorted_set", err.(Error).Code())
+		assert.Equal(t, 3, err.(Error).Params()["index"])
+	}
+}
+
+func TestOrderedBy(t *testing.T) {
+	type event struct {
+		Time  time.Time
+		Seq   int
+		label string
+	}
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := []event{{Time: t0}, {Time: t0.Add(time.Hour)}, {Time: t0.Add(time.Hour)}, {Time: t0.Add(2 * time.Hour)}}
+	unordered := []*event{{Time: t0, Seq: 3}, {Time: t0.Add(time.Hour), Seq: 2}, {Time: t0.Add(-time.Hour), Seq: 1}}
+
+	tests := []struct {
+		tag   string
+		rule  OrderRule
+		value interface{}
+		err   string
+	}{
+		{"t1", OrderedBy("Time", true), events, ""},
+		{"t2", OrderedBy("Time", false), events, "values must be in descending order"},
+		{"t3", OrderedBy("Time", true), unordered, "values must be in ascending order"},
+		{"t4", OrderedBy("Seq", false), unordered, ""},
+		{"t5", OrderedBy("Time", true), []event{}, ""},
+		{"t6", OrderedBy("Time", true), []event{{}}, ""},
+		{"t7", OrderedBy("Missing", true), events, "field Missing cannot be found in validation.event"},
+		{"t8", OrderedBy("label", true), events, "field label cannot be found in validation.event"},
+		{"t9", OrderedBy("Time", true), []*event{{}, nil}, "element #1 must not be nil"},
+		{"t10", OrderedBy("Time", true), []int{1, 2}, "element #0 must be a struct"},
+		{"t11", OrderedBy("Time", true), events[0], "must be a slice or an array"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := OrderedBy("Time", true).Validate(unordered)
+	if assert.NotNil(t, err) {
+		assert.Equal(t, 2, err.(Error).Params()["index"])
+	}
+}
+
+func TestOrderRule_Index(t *testing.T) {
+	err := Ascending.Validate([]int{1, 2, 5, 3, 4, 0})
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "validation_not_ascending", err.(Error).Code())
+		assert.Equal(t, 3, err.(Error).Params()["index"])
+	}
+	err = Descending.Validate([]int{3, 4})
+	if assert.NotNil(t, err) {
+		assert.Equal(t, 1, err.(Error).Params()["index"])
+	}
+}
+
+func Test_OrderRule_Error(t *testing.T) {
+	r := Ascending.Error("index {{.index}} is out of order")
+	assert.Equal(t, "index 2 is out of order", r.Validate([]int{1, 2, 1}).Error())
+	assert.Equal(t, "validation_not_ascending", r.err.Code())
+	assert.Nil(t, Ascending.err)
+
+	r = Descending.Error("123")
+	assert.Equal(t, "validation_not_descending", r.err.Code())
+
+	r = SortedSet.Error("123")
+	assert.Equal(t, "validation_not_sorted_set", r.err.Code())
+}
+
+func TestOrderRule_ErrorObject(t *testing.T) {
+	r := Ascending
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,83 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"regexp"
+)
+
+// ErrPatternsNoMatch is the error that returns when a value matches none of the allowed patterns.
+var ErrPatternsNoMatch = NewError("validation_patterns_no_match", "must match one of the allowed formats")
+
+// PatternsRule is a validation rule that checks if a value matches any of the patterns carried by the context.
+type PatternsRule struct {
+	key interface{}
+	err Error
+}
+
+// PatternsFromContext returns a validation rule that checks if a string or byte slice matches any of the
+// regular expressions stored in the context under the given key as a []*regexp.Regexp. This allows the
+// allowed formats to be chosen per request, e.g. per tenant, without creating new rules:
+//
+//	type tenantPatternsKey struct{}
+//
+//	rule := validation.PatternsFromContext(tenantPatternsKey{})
+//	ctx = context.WithValue(ctx, tenantPatternsKey{}, tenant.SKUPatterns)
+//	err := validation.ValidateWithContext(ctx, sku, rule)
+//
+// If the context has no patterns under the key, an InternalError is returned when validating, which is also
+// the case when the rule is used without a context. If the context holds an empty list, no value is allowed.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func PatternsFromContext(key interface{}) PatternsRule {
+	return PatternsRule{
+		key: key,
+		err: ErrPatternsNoMatch,
+	}
+}
+
+// Error sets the error message for the rule.
+func (r PatternsRule) Error(message string) PatternsRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r PatternsRule) ErrorObject(err Error) PatternsRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+// Since the patterns are carried by a context, it always returns an InternalError for a non-empty value.
+// Use ValidateWithContext instead.
+func (r PatternsRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(context.Background(), value)
+}
+
+// ValidateWithContext checks if the given value matches any of the patterns carried by the context.
+func (r PatternsRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	var patterns []*regexp.Regexp
+	found := false
+	if ctx != nil {
+		patterns, found = ctx.Value(r.key).([]*regexp.Regexp)
+	}
+	if !found {
+		return NewInternalError(errors.New("no patterns are found in the context"))
+	}
+
+	isString, str, isBytes, bs := StringOrBytes(value)
+	if !isString && !isBytes {
+		return r.err
+	}
+	for _, re := range patterns {
+		if isString && re.MatchString(str) || isBytes && re.Match(bs) {
+			return nil
+		}
+	}
+	return r.err
+}
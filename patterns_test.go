@@ -0,0 +1,62 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type patternsKey struct{}
+
+func TestPatternsFromContext(t *testing.T) {
+	patterns := []*regexp.Regexp{regexp.MustCompile(`^[A-Z]{3}-\d+$`), regexp.MustCompile(`^\d{6}$`)}
+	ctx := context.WithValue(context.Background(), patternsKey{}, patterns)
+	var s *string
+	tests := []struct {
+		tag   string
+		ctx   context.Context
+		value interface{}
+		err   string
+	}{
+		{"t1", ctx, "ABC-123", ""},
+		{"t2", ctx, "123456", ""},
+		{"t3", ctx, []byte("123456"), ""},
+		{"t4", ctx, "abc-123", "must match one of the allowed formats"},
+		{"t5", ctx, []byte("12345"), "must match one of the allowed formats"},
+		{"t6", ctx, "", ""},
+		{"t7", ctx, s, ""},
+		{"t8", ctx, 123, "must match one of the allowed formats"},
+		{"t9", context.WithValue(ctx, patternsKey{}, []*regexp.Regexp{}), "123456", "must match one of the allowed formats"},
+		{"t10", context.Background(), "123456", "no patterns are found in the context"},
+		{"t11", context.Background(), "", ""},
+	}
+
+	for _, test := range tests {
+		err := PatternsFromContext(patternsKey{}).ValidateWithContext(test.ctx, test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := PatternsFromContext(patternsKey{}).Validate("123456")
+	assert.Equal(t, NewInternalError(errors.New("no patterns are found in the context")), err)
+}
+
+func Test_PatternsRule_Error(t *testing.T) {
+	r := PatternsFromContext(patternsKey{})
+	assert.Equal(t, "must match one of the allowed formats", r.err.Message())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestPatternsRule_ErrorObject(t *testing.T) {
+	r := PatternsFromContext(patternsKey{})
+
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
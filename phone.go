@@ -0,0 +1,108 @@
+package validation
+
+import (
+	"errors"
+	"sync"
+)
+
+// PhoneValidatorFunc validates a phone number in the national format of the given region.
+// The region is an ISO 3166-1 alpha-2 country code, such as "US". It returns a non-nil error if the number is invalid.
+type PhoneValidatorFunc func(num, region string) error
+
+// ErrPhoneInvalid is the error that returns in case of an invalid phone number.
+var ErrPhoneInvalid = NewError("validation_phone_invalid", "must be a valid phone number")
+
+var (
+	phoneValidator   PhoneValidatorFunc = basicPhoneValidator
+	phoneValidatorMu sync.RWMutex
+)
+
+// SetPhoneValidator sets the function used by the Phone rule to validate phone numbers, e.g. a function backed by
+// a libphonenumber port. Passing nil restores the built-in validator.
+func SetPhoneValidator(f PhoneValidatorFunc) {
+	if f == nil {
+		f = basicPhoneValidator
+	}
+	phoneValidatorMu.Lock()
+	defer phoneValidatorMu.Unlock()
+	phoneValidator = f
+}
+
+// PhoneRule is a validation rule that checks if a string is a valid phone number of a region.
+type PhoneRule struct {
+	region string
+	err    Error
+}
+
+// Phone returns a validation rule that checks if a string is a valid phone number in the national format of the
+// given region, such as "US". The number is checked by the function set with SetPhoneValidator.
+// If the function returns an Error or an InternalError, that error is returned as is; otherwise the error of
+// this rule is returned.
+//
+// The built-in validator does not know the numbering plans of the regions. It only checks that the number
+// consists of 4 to 15 digits, optionally preceded by "+" and separated by spaces, dashes, dots or parentheses.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Phone(region string) PhoneRule {
+	return PhoneRule{
+		region: region,
+		err:    ErrPhoneInvalid.SetParams(map[string]interface{}{"region": region}),
+	}
+}
+
+// Error sets the error message for the rule.
+func (r PhoneRule) Error(message string) PhoneRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r PhoneRule) ErrorObject(err Error) PhoneRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r PhoneRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	phoneValidatorMu.RLock()
+	f := phoneValidator
+	phoneValidatorMu.RUnlock()
+
+	err = f(str, r.region)
+	if err == nil {
+		return nil
+	}
+	switch err.(type) {
+	case Error, InternalError:
+		return err
+	}
+	return r.err
+}
+
+// basicPhoneValidator checks if a number consists of digits and common separators only.
+func basicPhoneValidator(num, _ string) error {
+	digits := 0
+	for i, c := range num {
+		switch {
+		case c >= '0' && c <= '9':
+			digits++
+		case c == '+' && i == 0:
+		case c == ' ' || c == '-' || c == '.' || c == '(' || c == ')':
+		default:
+			return errors.New("invalid phone number character")
+		}
+	}
+	if digits < 4 || digits > 15 {
+		return errors.New("invalid number of digits in phone number")
+	}
+	return nil
+}
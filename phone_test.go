@@ -0,0 +1,85 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPhone(t *testing.T) {
+	var s *string
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", "(555) 123-4567", ""},
+		{"t2", "+1 555.123.4567", ""},
+		{"t3", "555-CALL-NOW", "must be a valid phone number"},
+		{"t4", "12", "must be a valid phone number"},
+		{"t5", "1234567890123456", "must be a valid phone number"},
+		{"t6", "555+1234", "must be a valid phone number"},
+		{"t7", "", ""},
+		{"t8", s, ""},
+		{"t9", []byte("5551234"), ""},
+		{"t10", 5551234, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		err := Phone("US").Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := Phone("US").Validate("abc")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "US", err.(Error).Params()["region"])
+	}
+}
+
+func TestSetPhoneValidator(t *testing.T) {
+	defer SetPhoneValidator(nil)
+
+	var regions []string
+	SetPhoneValidator(func(num, region string) error {
+		regions = append(regions, region)
+		switch num {
+		case "030 123456":
+			return nil
+		case "invalid":
+			return NewError("custom", "custom error")
+		case "failure":
+			return NewInternalError(errors.New("lookup failed"))
+		}
+		return errors.New("invalid")
+	})
+
+	assert.Nil(t, Phone("DE").Validate("030 123456"))
+	assertError(t, "must be a valid phone number", Phone("DE").Validate("123"), "t1")
+	assertError(t, "custom error", Phone("DE").Validate("invalid"), "t2")
+	err := Phone("DE").Validate("failure")
+	if assert.NotNil(t, err) {
+		_, ok := err.(InternalError)
+		assert.True(t, ok)
+	}
+	assert.Equal(t, []string{"DE", "DE", "DE", "DE"}, regions)
+
+	SetPhoneValidator(nil)
+	assertError(t, "must be a valid phone number", Phone("DE").Validate("invalid"), "t3")
+}
+
+func Test_PhoneRule_Error(t *testing.T) {
+	r := Phone("US").Error("invalid {{.region}} phone number")
+	assert.Equal(t, "invalid US phone number", r.Validate("abc").Error())
+	assert.Equal(t, "must be a valid phone number", Phone("US").err.Message())
+}
+
+func TestPhoneRule_ErrorObject(t *testing.T) {
+	r := Phone("US")
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,69 @@
+package validation
+
+import "unicode"
+
+// ErrControlCharacters is the error that returns when a string contains control characters.
+// Whether a null byte was found is available as the "nullByte" parameter.
+var ErrControlCharacters = NewError("validation_control_characters", "must not contain control characters")
+
+// Printable is a validation rule that checks if a string does not contain control characters, as determined
+// by unicode.IsControl, other than the tab, line feed and carriage return characters.
+// This is useful for values that are stored and displayed to users.
+// The "nullByte" parameter of the error reports whether a null byte was found, which often indicates
+// an attempt to truncate the value in systems based on C strings.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+var Printable = PrintableRule{}
+
+// PrintableRule is a validation rule that checks if a string does not contain control characters.
+type PrintableRule struct {
+	err Error
+}
+
+// Error sets the error message for the rule.
+func (r PrintableRule) Error(message string) PrintableRule {
+	r.err = r.defaultError().SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r PrintableRule) ErrorObject(err Error) PrintableRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r PrintableRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	found, nullByte := false, false
+	for _, c := range str {
+		if unicode.IsControl(c) && c != '\t' && c != '\n' && c != '\r' {
+			found = true
+			if c == 0 {
+				nullByte = true
+				break
+			}
+		}
+	}
+	if found {
+		return r.defaultError().SetParams(map[string]interface{}{"nullByte": nullByte})
+	}
+
+	return nil
+}
+
+// defaultError returns the error set for the rule, or ErrControlCharacters.
+func (r PrintableRule) defaultError() Error {
+	if r.err != nil {
+		return r.err
+	}
+	return ErrControlCharacters
+}
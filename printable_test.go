@@ -0,0 +1,62 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintable(t *testing.T) {
+	var s *string
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", "", ""},
+		{"t2", "Hello, 世界!", ""},
+		{"t3", "line 1\nline 2\r\n\tindented", ""},
+		{"t4", "abc\x00def", "must not contain control characters"},
+		{"t5", "abc\x1bdef", "must not contain control characters"},
+		{"t6", "abc\u0085", "must not contain control characters"},
+		{"t7", "abc\x7f", "must not contain control characters"},
+		{"t8", []byte("abc"), ""},
+		{"t9", []byte("a\x07"), "must not contain control characters"},
+		{"t10", s, ""},
+		{"t11", 1, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		err := Printable.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestPrintable_NullByte(t *testing.T) {
+	err := Printable.Validate("a\x1bb\x00c")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "validation_control_characters", err.(Error).Code())
+		assert.Equal(t, true, err.(Error).Params()["nullByte"])
+	}
+	err = Printable.Validate("a\x1bb")
+	if assert.NotNil(t, err) {
+		assert.Equal(t, false, err.(Error).Params()["nullByte"])
+	}
+}
+
+func Test_PrintableRule_Error(t *testing.T) {
+	r := Printable.Error("123")
+	assert.Equal(t, "123", r.Validate("\x00").Error())
+	assert.Equal(t, "validation_control_characters", r.err.Code())
+	assert.Nil(t, Printable.err)
+}
+
+func TestPrintableRule_ErrorObject(t *testing.T) {
+	r := Printable
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,64 @@
+package validation
+
+import "net/http"
+
+// ProblemJSONContentType is the media type of a ProblemDetails serialized into JSON.
+const ProblemJSONContentType = "application/problem+json"
+
+// ProblemDetails is an RFC 7807 problem details object reporting validation errors.
+// It can be serialized into JSON and sent with the ProblemJSONContentType content type.
+type ProblemDetails struct {
+	// Type is a URI reference that identifies the problem type.
+	Type string `json:"type,omitempty"`
+	// Title is a short, human-readable summary of the problem type.
+	Title string `json:"title,omitempty"`
+	// Status is the HTTP status code of the response.
+	Status int `json:"status,omitempty"`
+	// Detail is a human-readable explanation specific to this occurrence of the problem.
+	Detail string `json:"detail,omitempty"`
+	// Instance is a URI reference that identifies the specific occurrence of the problem.
+	Instance string `json:"instance,omitempty"`
+	// Errors lists the validation errors.
+	Errors []ProblemError `json:"errors,omitempty"`
+}
+
+// ProblemError is a validation error reported by ProblemDetails.
+type ProblemError struct {
+	// Pointer is the RFC 6901 JSON pointer of the invalid value in the request body, e.g. "/address/zip".
+	Pointer string `json:"pointer"`
+	// Detail is the error message.
+	Detail string `json:"detail"`
+}
+
+// ToProblemDetails converts a validation error into an RFC 7807 problem details object with the given HTTP status.
+// For example,
+//
+//	if err := req.Validate(); err != nil {
+//	    w.Header().Set("Content-Type", validation.ProblemJSONContentType)
+//	    w.WriteHeader(http.StatusUnprocessableEntity)
+//	    json.NewEncoder(w).Encode(validation.ToProblemDetails(err, http.StatusUnprocessableEntity))
+//	    return
+//	}
+//
+// The type of the problem is "about:blank" and the title is the text of the status. They can be changed, and
+// the detail and instance can be set, by modifying the returned object.
+// Each message of an Errors is listed in the "errors" member with the JSON pointer of the error, built from the
+// keys of the nested Errors (e.g. "/address/zip"). The errors are sorted by pointer. A single validation error
+// is listed with an empty pointer, which refers to the whole document.
+// Nil is returned if the error is nil or is not a validation error, e.g. an InternalError.
+func ToProblemDetails(err error, status int) *ProblemDetails {
+	messages, ok := fieldMessages(err, joinJSONPointer)
+	if !ok {
+		return nil
+	}
+
+	pd := &ProblemDetails{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+	}
+	for _, m := range messages {
+		pd.Errors = append(pd.Errors, ProblemError{Pointer: m.Field, Detail: m.Message})
+	}
+	return pd
+}
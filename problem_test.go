@@ -0,0 +1,48 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToProblemDetails(t *testing.T) {
+	err := Errors{
+		"name": ErrRequired,
+		"address": Errors{
+			"zip":    ErrLengthOutOfRange.SetParams(map[string]interface{}{"min": 5, "max": 5}),
+			"street": nil,
+		},
+		"items": Errors{"0": Errors{"name": ErrRequired, "": ErrNotNilRequired}},
+		"a/b~c": errors.Join(ErrRequired, ErrNotNilRequired),
+	}
+	pd := ToProblemDetails(err, http.StatusUnprocessableEntity)
+	if assert.NotNil(t, pd) {
+		assert.Equal(t, &ProblemDetails{
+			Type:   "about:blank",
+			Title:  "Unprocessable Entity",
+			Status: http.StatusUnprocessableEntity,
+			Errors: []ProblemError{
+				{Pointer: "/address/zip", Detail: "the length must be between 5 and 5"},
+				{Pointer: "/a~1b~0c", Detail: "cannot be blank"},
+				{Pointer: "/a~1b~0c", Detail: "is required"},
+				{Pointer: "/items/0", Detail: "is required"},
+				{Pointer: "/items/0/name", Detail: "cannot be blank"},
+				{Pointer: "/name", Detail: "cannot be blank"},
+			},
+		}, pd)
+	}
+
+	pd = ToProblemDetails(ErrRequired, http.StatusBadRequest)
+	if assert.NotNil(t, pd) {
+		b, _ := json.Marshal(pd)
+		assert.Equal(t, `{"type":"about:blank","title":"Bad Request","status":400,"errors":[{"pointer":"","detail":"cannot be blank"}]}`, string(b))
+	}
+
+	assert.Nil(t, ToProblemDetails(nil, http.StatusBadRequest))
+	assert.Nil(t, ToProblemDetails(errors.New("abc"), http.StatusBadRequest))
+	assert.Nil(t, ToProblemDetails(NewInternalError(errors.New("abc")), http.StatusBadRequest))
+}
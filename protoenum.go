@@ -0,0 +1,86 @@
+package validation
+
+import (
+	"math"
+	"reflect"
+)
+
+// ErrProtoEnumInvalid is the error that returns in case of an undefined enum value.
+var ErrProtoEnumInvalid = NewError("validation_proto_enum_invalid", "must be a valid enum value")
+
+// ProtoEnumRule is a validation rule that checks if an integer is a defined value of a protobuf enum.
+type ProtoEnumRule struct {
+	names       map[int32]string
+	excludeZero bool
+	err         Error
+}
+
+// ProtoEnum returns a validation rule that checks if an integer is one of the values defined by a protobuf enum.
+// The enum values are given by the name map found in the protobuf-generated code, e.g.
+//
+//	err := validation.Validate(req.Status, validation.ProtoEnum(pb.Status_name))
+//
+// The value can be an int32, a generated enum type, or any other signed or unsigned integer type.
+// Like other rules, the zero value is considered valid. Call ExcludeZero to reject the zero value,
+// which protobuf enums conventionally reserve for "UNSPECIFIED".
+func ProtoEnum(nameMap map[int32]string) ProtoEnumRule {
+	return ProtoEnumRule{
+		names: nameMap,
+		err:   ErrProtoEnumInvalid,
+	}
+}
+
+// ExcludeZero makes the rule reject the zero value, even if it is defined by the enum.
+func (r ProtoEnumRule) ExcludeZero() ProtoEnumRule {
+	r.excludeZero = true
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r ProtoEnumRule) Error(message string) ProtoEnumRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r ProtoEnumRule) ErrorObject(err Error) ProtoEnumRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r ProtoEnumRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil {
+		return nil
+	}
+
+	var n int64
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := ToUint(value)
+		if err != nil {
+			return err
+		}
+		if u > math.MaxInt32 {
+			return r.err
+		}
+		n = int64(u)
+	default:
+		var err error
+		if n, err = ToInt(value); err != nil {
+			return err
+		}
+	}
+	if n == 0 && !r.excludeZero {
+		return nil
+	}
+	if n == 0 || n < math.MinInt32 || n > math.MaxInt32 {
+		return r.err
+	}
+	if _, ok := r.names[int32(n)]; !ok {
+		return r.err
+	}
+
+	return nil
+}
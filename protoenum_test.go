@@ -0,0 +1,70 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testStatus int32
+
+var testStatusName = map[int32]string{
+	0: "STATUS_UNSPECIFIED",
+	1: "STATUS_ACTIVE",
+	2: "STATUS_INACTIVE",
+}
+
+func TestProtoEnum(t *testing.T) {
+	var s *testStatus
+	active := testStatus(1)
+	tests := []struct {
+		tag         string
+		excludeZero bool
+		value       interface{}
+		err         string
+	}{
+		{"t1", false, int32(1), ""},
+		{"t2", false, testStatus(2), ""},
+		{"t3", false, testStatus(3), "must be a valid enum value"},
+		{"t4", false, int32(-1), "must be a valid enum value"},
+		{"t5", false, testStatus(0), ""},
+		{"t6", true, testStatus(0), "must be a valid enum value"},
+		{"t7", true, testStatus(1), ""},
+		{"t8", false, s, ""},
+		{"t9", true, s, ""},
+		{"t10", false, &active, ""},
+		{"t11", false, int64(1) << 33, "must be a valid enum value"},
+		{"t12", false, "1", "cannot convert string to int64"},
+		{"t13", false, nil, ""},
+		{"t14", false, uint32(2), ""},
+		{"t15", false, uint8(3), "must be a valid enum value"},
+		{"t16", true, uint(0), "must be a valid enum value"},
+		{"t17", false, uint64(1) << 63, "must be a valid enum value"},
+	}
+
+	for _, test := range tests {
+		r := ProtoEnum(testStatusName)
+		if test.excludeZero {
+			r = r.ExcludeZero()
+		}
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func Test_ProtoEnumRule_Error(t *testing.T) {
+	r := ProtoEnum(testStatusName)
+	assert.Equal(t, "must be a valid enum value", r.Validate(testStatus(5)).Error())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestProtoEnumRule_ErrorObject(t *testing.T) {
+	r := ProtoEnum(testStatusName)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
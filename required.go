@@ -18,36 +18,91 @@ var (
 // - string, array, slice, map: len() > 0
 // - interface, pointer: not nil and the referenced value is not empty
 // - any other types
+//
+// Note that Required treats both a nil and an empty slice or map as blank. Use NotNil if
+// a non-nil empty slice or map should be considered present.
 var Required = RequiredRule{skipNil: false, condition: true}
 
 // NilOrNotEmpty checks if a value is a nil pointer or a value that is not empty.
 // NilOrNotEmpty differs from Required in that it treats a nil pointer as valid.
 var NilOrNotEmpty = RequiredRule{skipNil: true, condition: true}
 
+// RequiredWith returns a validation rule that checks if a value is not empty when any of the specified
+// fields is not empty. The fields must be specified as pointers to them, usually sibling fields of the
+// struct being validated. For example,
+//
+//	validation.ValidateStruct(&c,
+//	    validation.Field(&c.State, validation.RequiredWith(&c.Country)),
+//	)
+func RequiredWith(fieldPtrs ...interface{}) RequiredRule {
+	return RequiredRule{condition: true, with: fieldPtrs}
+}
+
+// RequiredWithout returns a validation rule that checks if a value is not empty when any of the specified
+// fields is empty. The fields must be specified as pointers to them, usually sibling fields of the
+// struct being validated. For example,
+//
+//	validation.ValidateStruct(&c,
+//	    validation.Field(&c.Email, validation.RequiredWithout(&c.Phone)),
+//	)
+func RequiredWithout(fieldPtrs ...interface{}) RequiredRule {
+	return RequiredRule{condition: true, without: fieldPtrs}
+}
+
 // RequiredRule is a rule that checks if a value is not empty.
 type RequiredRule struct {
 	condition bool
 	skipNil   bool
 	err       Error
+
+	with, without []interface{}
 }
 
 // Validate checks if the given value is valid or not.
 func (r RequiredRule) Validate(value interface{}) error {
-	if r.condition {
+	if r.condition && r.dependenciesMet() {
 		value, isNil := Indirect(value)
-		if r.skipNil && !isNil && IsEmpty(value) || !r.skipNil && (isNil || IsEmpty(value)) {
-			if r.err != nil {
-				return r.err
-			}
-			if r.skipNil {
-				return ErrNilOrNotEmpty
-			}
-			return ErrRequired
+		return r.check(isNil, IsEmpty(value))
+	}
+	return nil
+}
+
+// check returns the error of the rule for a value that is nil and/or empty as indicated.
+func (r RequiredRule) check(isNil, isEmpty bool) error {
+	if r.skipNil && !isNil && isEmpty || !r.skipNil && (isNil || isEmpty) {
+		if r.err != nil {
+			return r.err
 		}
+		if r.skipNil {
+			return ErrNilOrNotEmpty
+		}
+		return ErrRequired
 	}
 	return nil
 }
 
+// dependenciesMet checks if the fields specified via RequiredWith and RequiredWithout require the value to be present.
+func (r RequiredRule) dependenciesMet() bool {
+	if len(r.with) > 0 && !anyOfFields(r.with, false) {
+		return false
+	}
+	if len(r.without) > 0 && !anyOfFields(r.without, true) {
+		return false
+	}
+	return true
+}
+
+// anyOfFields checks if any of the given field pointers references an empty value (if empty is true)
+// or a non-empty value (if empty is false).
+func anyOfFields(fieldPtrs []interface{}, empty bool) bool {
+	for _, fieldPtr := range fieldPtrs {
+		if IsEmpty(fieldPtr) == empty {
+			return true
+		}
+	}
+	return false
+}
+
 // When sets the condition that determines if the validation should be performed.
 func (r RequiredRule) When(condition bool) RequiredRule {
 	r.condition = condition
@@ -45,6 +45,45 @@ func TestRequiredRule_When(t *testing.T) {
 	assert.Equal(t, ErrRequired, err)
 }
 
+func TestRequiredWith(t *testing.T) {
+	type address struct {
+		Country string
+		State   string
+		Phone   *string
+		Email   string
+	}
+	phone := "123"
+	tests := []struct {
+		tag   string
+		value address
+		err   string
+	}{
+		{"t1", address{}, "Email: cannot be blank."},
+		{"t2", address{Country: "US"}, "Email: cannot be blank; State: cannot be blank."},
+		{"t3", address{Country: "US", State: "CA", Email: "a@b.c"}, ""},
+		{"t4", address{State: "CA", Phone: &phone}, ""},
+		{"t5", address{Phone: &phone, Email: "a@b.c"}, ""},
+	}
+
+	for _, test := range tests {
+		a := test.value
+		err := ValidateStruct(&a,
+			Field(&a.State, RequiredWith(&a.Country)),
+			Field(&a.Email, RequiredWithout(&a.Phone)),
+		)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	country, state := "", ""
+	assert.Nil(t, Validate("", RequiredWith(&country, &state)))
+	state = "CA"
+	assert.Equal(t, ErrRequired, Validate("", RequiredWith(&country, &state)))
+	assert.Nil(t, Validate("", RequiredWithout(&state)))
+	assert.Equal(t, ErrRequired, Validate("", RequiredWithout(&country, &state)))
+	assert.Nil(t, Validate("", RequiredWithout(&country).When(false)))
+	assert.EqualError(t, Validate("", RequiredWithout(&country).Error("abc")), "abc")
+}
+
 func TestNilOrNotEmpty(t *testing.T) {
 	s1 := "123"
 	s2 := ""
@@ -0,0 +1,80 @@
+package validation
+
+import "context"
+
+// RuleSetRule is a validation rule that consists of a reusable list of rules.
+type RuleSetRule struct {
+	rules []Rule
+}
+
+// RuleSet returns a validation rule that groups the given rules so that they can be reused as a single rule,
+// which keeps the policy for a kind of field in one place. For example,
+//
+//	var UsernameRules = validation.RuleSet(validation.Required, validation.Length(3, 20), is.Alphanumeric)
+//
+//	err := validation.ValidateStruct(&s,
+//	    validation.Field(&s.Username, UsernameRules),
+//	    validation.Field(&s.ReferrerUsername, validation.Optional, UsernameRules),
+//	)
+//
+// When validating a value, a rule set is expanded into its rules as if they were listed in its place, so Skip
+// and Optional in a rule set also apply to the rules following the rule set. Rule sets may be nested.
+func RuleSet(rules ...Rule) RuleSetRule {
+	return RuleSetRule{rules: rules}
+}
+
+// Rules returns the rules of the rule set, with the nested rule sets expanded.
+func (r RuleSetRule) Rules() []Rule {
+	return expandRuleSets(r.rules)
+}
+
+// Validate checks if the given value is valid or not.
+func (r RuleSetRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(context.Background(), value)
+}
+
+// ValidateWithContext checks if the given value is valid or not using the rules of the rule set,
+// stopping at the first error. The context is passed to the rules that implement RuleWithContext.
+func (r RuleSetRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	for _, rule := range r.Rules() {
+		if s, ok := rule.(skipRule); ok && s.skip {
+			return nil
+		}
+		if _, ok := rule.(optionalRule); ok && isEmptyValue(value) {
+			return nil
+		}
+		if rc, ok := rule.(RuleWithContext); ok && ctx != nil {
+			if err := rc.ValidateWithContext(ctx, value); err != nil {
+				return err
+			}
+		} else if err := rule.Validate(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expandRuleSets replaces the rule sets in the given rules with their rules.
+// The rules are returned as is if there is no rule set among them.
+func expandRuleSets(rules []Rule) []Rule {
+	i := 0
+	for ; i < len(rules); i++ {
+		if _, ok := rules[i].(RuleSetRule); ok {
+			break
+		}
+	}
+	if i == len(rules) {
+		return rules
+	}
+
+	res := make([]Rule, i, len(rules))
+	copy(res, rules[:i])
+	for _, rule := range rules[i:] {
+		if rs, ok := rule.(RuleSetRule); ok {
+			res = append(res, rs.Rules()...)
+		} else {
+			res = append(res, rule)
+		}
+	}
+	return res
+}
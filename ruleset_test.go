@@ -0,0 +1,65 @@
+package validation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type ruleSetKey struct{}
+
+func TestRuleSet(t *testing.T) {
+	nameRules := RuleSet(Required, Length(3, 5))
+	tests := []struct {
+		tag   string
+		value interface{}
+		rules []Rule
+		err   string
+	}{
+		{"t1", "abc", []Rule{nameRules}, ""},
+		{"t2", "", []Rule{nameRules}, "cannot be blank"},
+		{"t3", "ab", []Rule{nameRules}, "the length must be between 3 and 5"},
+		{"t4", "", []Rule{Optional, nameRules}, ""},
+		{"t5", "abcdef", []Rule{RuleSet(nameRules, In("abcdef")), Length(1, 2)}, "the length must be between 3 and 5"},
+		{"t6", "", []Rule{RuleSet(Optional, Length(3, 5)), Required}, ""},
+		{"t7", "abc", []Rule{RuleSet(Skip), Length(1, 2)}, ""},
+		{"t8", []byte("ab"), []Rule{nameRules}, "the length must be between 3 and 5"},
+		{"t9", "abc", []Rule{RuleSet()}, ""},
+	}
+	for _, test := range tests {
+		err := Validate(test.value, test.rules...)
+		assertError(t, test.err, err, test.tag)
+		err = ValidateWithContext(context.Background(), test.value, test.rules...)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := nameRules.Validate("ab")
+	assertError(t, "the length must be between 3 and 5", err, "t10")
+	err = RuleSet(Optional, nameRules).Validate("")
+	assertError(t, "", err, "t11")
+
+	ctxRule := WithContext(func(ctx context.Context, value interface{}) error {
+		if ctx.Value(ruleSetKey{}) == value {
+			return nil
+		}
+		return ErrInInvalid
+	})
+	ctx := context.WithValue(context.Background(), ruleSetKey{}, "abc")
+	err = RuleSet(ctxRule).ValidateWithContext(ctx, "abc")
+	assertError(t, "", err, "t12")
+	err = ValidateWithContext(ctx, "xyz", RuleSet(Required, ctxRule))
+	assertError(t, "must be a valid value", err, "t13")
+
+	s := struct {
+		First, Last string
+	}{"Jo", ""}
+	err = ValidateStruct(&s, Field(&s.First, nameRules), Field(&s.Last, nameRules))
+	assert.EqualError(t, err, "First: the length must be between 3 and 5; Last: cannot be blank.")
+}
+
+func TestRuleSetRule_Rules(t *testing.T) {
+	r := RuleSet(Required, RuleSet(Length(1, 2), RuleSet(Optional)), In("a"))
+	assert.Equal(t, []Rule{Required, Length(1, 2), Optional, In("a")}, r.Rules())
+	assert.Empty(t, RuleSet().Rules())
+}
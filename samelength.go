@@ -0,0 +1,96 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ErrLengthsMismatch is the error that returns when the slice fields of a struct have different numbers of elements.
+var ErrLengthsMismatch = NewError("validation_lengths_mismatch", "{{.fields}} must have the same number of elements")
+
+// SameLengthRule is a validation rule that checks if several slice fields of a struct have the same length.
+type SameLengthRule struct {
+	fieldPtrs []interface{}
+	err       Error
+}
+
+// SameLength returns a struct-level validation rule that checks if the given fields of a struct have the same number
+// of elements. This is useful for parallel arrays, such as a list of names and a list of ages that must align.
+// The fields must be specified as pointers to the fields of the struct being validated, and the struct must be
+// specified as a pointer to it. For example,
+//
+//	err := validation.Validate(&s, validation.SameLength(&s.Names, &s.Ages))
+//	fmt.Println(err)
+//	// Names and Ages must have the same number of elements
+//
+// The fields can be slices, arrays, maps or strings. Their error names, determined as in ValidateStruct,
+// are available as the "fields" parameter of the error. A nil struct pointer is considered valid.
+func SameLength(fieldPtrs ...interface{}) SameLengthRule {
+	return SameLengthRule{
+		fieldPtrs: fieldPtrs,
+		err:       ErrLengthsMismatch,
+	}
+}
+
+// Error sets the error message for the rule.
+func (r SameLengthRule) Error(message string) SameLengthRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r SameLengthRule) ErrorObject(err Error) SameLengthRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r SameLengthRule) Validate(value interface{}) error {
+	sv := reflect.ValueOf(value)
+	if sv.Kind() != reflect.Ptr || !sv.IsNil() && sv.Elem().Kind() != reflect.Struct {
+		return NewInternalError(ErrStructPointer)
+	}
+	if sv.IsNil() {
+		return nil
+	}
+	sv = sv.Elem()
+
+	names := make([]string, len(r.fieldPtrs))
+	length, same := 0, true
+	for i, fieldPtr := range r.fieldPtrs {
+		fv := reflect.ValueOf(fieldPtr)
+		if fv.Kind() != reflect.Ptr {
+			return NewInternalError(ErrFieldPointer(i))
+		}
+		ft, name := lookupStructField(sv, fv)
+		if ft == nil {
+			return NewInternalError(ErrFieldNotFound(i))
+		}
+		names[i] = name
+
+		switch fv.Elem().Kind() {
+		case reflect.Slice, reflect.Array, reflect.Map, reflect.String:
+		default:
+			return NewInternalError(fmt.Errorf("field %v must be a slice, an array, a map or a string", names[i]))
+		}
+		if l := fv.Elem().Len(); i == 0 {
+			length = l
+		} else if l != length {
+			same = false
+		}
+	}
+
+	if same {
+		return nil
+	}
+	return r.err.SetParams(map[string]interface{}{"fields": joinFieldNames(names)})
+}
+
+// joinFieldNames joins field names into a phrase such as "a, b and c".
+func joinFieldNames(names []string) string {
+	if len(names) < 2 {
+		return strings.Join(names, "")
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
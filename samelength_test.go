@@ -0,0 +1,66 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSameLength(t *testing.T) {
+	type request struct {
+		Names  []string `json:"names"`
+		Ages   []int    `json:"ages"`
+		Emails []string
+		Count  int
+	}
+	r := request{Names: []string{"a", "b"}, Ages: []int{1, 2}, Emails: []string{"x"}}
+	var nilRequest *request
+	other := 1
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		rule  SameLengthRule
+		err   string
+	}{
+		{"t1", &r, SameLength(&r.Names, &r.Ages), ""},
+		{"t2", &r, SameLength(&r.Names, &r.Ages, &r.Emails), "names, ages and Emails must have the same number of elements"},
+		{"t3", &r, SameLength(&r.Names, &r.Emails), "names and Emails must have the same number of elements"},
+		{"t4", &r, SameLength(&r.Names), ""},
+		{"t5", &r, SameLength(), ""},
+		{"t6", nilRequest, SameLength(), ""},
+		{"t7", r, SameLength(&r.Names, &r.Ages), "only a pointer to a struct can be validated"},
+		{"t8", &r, SameLength(r.Names, &r.Ages), "field #0 must be specified as a pointer"},
+		{"t9", &r, SameLength(&r.Names, &other), "field #1 cannot be found in the struct"},
+		{"t10", &r, SameLength(&r.Names, &r.Count), "field Count must be a slice, an array, a map or a string"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	r.Ages = nil
+	err := Validate(&r, SameLength(&r.Names, &r.Ages))
+	if assert.NotNil(t, err) {
+		assert.Equal(t, "validation_lengths_mismatch", err.(Error).Code())
+		assert.Equal(t, "names and ages", err.(Error).Params()["fields"])
+	}
+}
+
+func Test_SameLengthRule_Error(t *testing.T) {
+	s := struct{ A, B []int }{A: []int{1}}
+	r := SameLength(&s.A, &s.B).Error("{{.fields}} must align")
+	assertError(t, "A and B must align", r.Validate(&s), "t1")
+	assert.Equal(t, "{{.fields}} must have the same number of elements", SameLength().err.Message())
+}
+
+func TestSameLengthRule_ErrorObject(t *testing.T) {
+	r := SameLength()
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
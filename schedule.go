@@ -0,0 +1,158 @@
+package validation
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrOutsideSchedule is the error that returns when a time is outside the allowed hours of a schedule.
+var ErrOutsideSchedule = NewError("validation_outside_schedule", "time is outside allowed hours")
+
+// Schedule is a set of weekly time windows, such as the opening hours of a business.
+// Use NewSchedule to create a schedule and Add or Weekdays to add windows to it. For example,
+//
+//	schedule := validation.NewSchedule().
+//	    Weekdays("09:00", "17:00").
+//	    Add(time.Saturday, "10:00", "14:00")
+//
+// A Schedule is immutable: Add, Weekdays and In return a modified copy.
+type Schedule struct {
+	windows []scheduleWindow
+	loc     *time.Location
+	err     error
+}
+
+// scheduleWindow is a time window within a day, specified as offsets from midnight.
+type scheduleWindow struct {
+	day        time.Weekday
+	start, end time.Duration
+}
+
+// NewSchedule returns an empty schedule. A time is never within an empty schedule.
+func NewSchedule() Schedule {
+	return Schedule{}
+}
+
+// Add returns a copy of the schedule with a window on the given day of the week, starting at from and
+// ending at to. The times are specified as "15:04", and the window includes its start but not its end.
+// The end can be "24:00" for a window that lasts until midnight. A window crossing midnight should be
+// added as two windows, one on each day.
+func (s Schedule) Add(day time.Weekday, from, to string) Schedule {
+	if s.err != nil {
+		return s
+	}
+	start, err := parseTimeOfDay(from)
+	if err != nil {
+		s.err = err
+		return s
+	}
+	end, err := parseTimeOfDay(to)
+	if err != nil {
+		s.err = err
+		return s
+	}
+	if end <= start {
+		s.err = fmt.Errorf("schedule window %v-%v on %v must end after it starts", from, to, day)
+		return s
+	}
+	// cap the slice so that appending never modifies the windows of the original schedule
+	s.windows = append(s.windows[:len(s.windows):len(s.windows)], scheduleWindow{day: day, start: start, end: end})
+	return s
+}
+
+// Weekdays returns a copy of the schedule with the same window added from Monday to Friday.
+// Please refer to Add for the format of the times.
+func (s Schedule) Weekdays(from, to string) Schedule {
+	for day := time.Monday; day <= time.Friday; day++ {
+		s = s.Add(day, from, to)
+	}
+	return s
+}
+
+// In returns a copy of the schedule whose windows are in the given location, e.g. the time zone of a venue.
+// By default, the windows are in the location of the time being checked.
+func (s Schedule) In(loc *time.Location) Schedule {
+	s.loc = loc
+	return s
+}
+
+// Contains checks if the given time is within one of the windows of the schedule.
+func (s Schedule) Contains(t time.Time) bool {
+	if s.loc != nil {
+		t = t.In(s.loc)
+	}
+	hour, min, sec := t.Clock()
+	offset := time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute +
+		time.Duration(sec)*time.Second + time.Duration(t.Nanosecond())
+	day := t.Weekday()
+	for _, w := range s.windows {
+		if w.day == day && offset >= w.start && offset < w.end {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTimeOfDay parses a time of day specified as "15:04" into an offset from midnight.
+func parseTimeOfDay(s string) (time.Duration, error) {
+	hh, mm, ok := strings.Cut(s, ":")
+	if ok && len(hh) == 2 && len(mm) == 2 {
+		h, err1 := strconv.Atoi(hh)
+		m, err2 := strconv.Atoi(mm)
+		if err1 == nil && err2 == nil && h >= 0 && m >= 0 && m < 60 && (h < 24 || h == 24 && m == 0) {
+			return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid time of day: %q", s)
+}
+
+// WithinScheduleRule is a validation rule that checks if a time is within a schedule.
+type WithinScheduleRule struct {
+	schedule Schedule
+	err      Error
+}
+
+// WithinSchedule returns a validation rule that checks if a time.Time value is within one of the windows
+// of the given schedule. Unless the schedule is set to a location with Schedule.In, the weekday and time of day
+// are those of the value in its own location.
+// If the schedule has an invalid window, an InternalError is returned when validating.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func WithinSchedule(schedule Schedule) WithinScheduleRule {
+	return WithinScheduleRule{schedule: schedule, err: ErrOutsideSchedule}
+}
+
+// Error sets the error message for the rule.
+func (r WithinScheduleRule) Error(message string) WithinScheduleRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r WithinScheduleRule) ErrorObject(err Error) WithinScheduleRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r WithinScheduleRule) Validate(value interface{}) error {
+	if r.schedule.err != nil {
+		return NewInternalError(r.schedule.err)
+	}
+
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	t, ok := value.(time.Time)
+	if !ok {
+		return fmt.Errorf("cannot convert %v to time.Time", reflect.TypeOf(value))
+	}
+	if !r.schedule.Contains(t) {
+		return r.err
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithinSchedule(t *testing.T) {
+	schedule := NewSchedule().
+		Weekdays("09:00", "17:00").
+		Add(time.Saturday, "10:00", "14:00").
+		Add(time.Saturday, "20:00", "24:00")
+	tokyo := time.FixedZone("JST", 9*60*60)
+	var tm *time.Time
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		// 2024-01-01 is a Monday
+		{"t1", time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC), ""},
+		{"t2", time.Date(2024, 1, 1, 16, 59, 59, 0, time.UTC), ""},
+		{"t3", time.Date(2024, 1, 1, 17, 0, 0, 0, time.UTC), "time is outside allowed hours"},
+		{"t4", time.Date(2024, 1, 1, 8, 59, 0, 0, time.UTC), "time is outside allowed hours"},
+		{"t5", time.Date(2024, 1, 6, 12, 0, 0, 0, time.UTC), ""},
+		{"t6", time.Date(2024, 1, 6, 15, 0, 0, 0, time.UTC), "time is outside allowed hours"},
+		{"t7", time.Date(2024, 1, 6, 23, 59, 0, 0, time.UTC), ""},
+		{"t8", time.Date(2024, 1, 7, 12, 0, 0, 0, time.UTC), "time is outside allowed hours"},
+		// the time of day is taken in the location of the value
+		{"t9", time.Date(2024, 1, 1, 10, 0, 0, 0, tokyo), ""},
+		{"t10", time.Date(2024, 1, 1, 10, 0, 0, 0, tokyo).UTC(), "time is outside allowed hours"},
+		{"t11", &time.Time{}, ""},
+		{"t12", tm, ""},
+		{"t13", "2024-01-01", "cannot convert string to time.Time"},
+	}
+
+	for _, test := range tests {
+		err := WithinSchedule(schedule).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestSchedule_In(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	r := WithinSchedule(NewSchedule().Weekdays("09:00", "17:00").In(tokyo))
+
+	// 01:00 UTC on a Monday is 10:00 in Tokyo
+	assert.Nil(t, r.Validate(time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC)))
+	assert.NotNil(t, r.Validate(time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)))
+}
+
+func TestSchedule_Invalid(t *testing.T) {
+	tests := []struct {
+		tag      string
+		schedule Schedule
+		err      string
+	}{
+		{"t1", NewSchedule().Add(time.Monday, "9:00", "17:00"), `invalid time of day: "9:00"`},
+		{"t2", NewSchedule().Add(time.Monday, "09:00", "24:30"), `invalid time of day: "24:30"`},
+		{"t3", NewSchedule().Add(time.Monday, "09:60", "17:00"), `invalid time of day: "09:60"`},
+		{"t4", NewSchedule().Add(time.Monday, "17:00", "09:00"), "schedule window 17:00-09:00 on Monday must end after it starts"},
+		{"t5", NewSchedule().Add(time.Monday, "ab:cd", "17:00").Weekdays("09:00", "17:00"), `invalid time of day: "ab:cd"`},
+	}
+
+	for _, test := range tests {
+		err := WithinSchedule(test.schedule).Validate(time.Now())
+		assertError(t, test.err, err, test.tag)
+		var ie InternalError
+		assert.True(t, errors.As(err, &ie), test.tag)
+	}
+}
+
+func TestSchedule_Immutable(t *testing.T) {
+	base := NewSchedule().Add(time.Monday, "09:00", "12:00")
+	a := base.Add(time.Monday, "13:00", "17:00")
+	b := base.Add(time.Tuesday, "09:00", "12:00")
+
+	monday := time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC)
+	assert.False(t, base.Contains(monday))
+	assert.True(t, a.Contains(monday))
+	assert.False(t, b.Contains(monday))
+	assert.False(t, NewSchedule().Contains(monday))
+}
+
+func Test_WithinScheduleRule_Error(t *testing.T) {
+	r := WithinSchedule(NewSchedule())
+	assert.Equal(t, "time is outside allowed hours", r.err.Message())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestWithinScheduleRule_ErrorObject(t *testing.T) {
+	r := WithinSchedule(NewSchedule())
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,115 @@
+package validation
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+)
+
+var (
+	// ErrNotPositive is the error that returns when a value is not positive.
+	ErrNotPositive = NewError("validation_not_positive", "must be positive")
+	// ErrNotNegative is the error that returns when a value is not negative.
+	ErrNotNegative = NewError("validation_not_negative", "must be negative")
+	// ErrNegative is the error that returns when a value is negative.
+	ErrNegative = NewError("validation_negative", "must not be negative")
+	// ErrPositive is the error that returns when a value is positive.
+	ErrPositive = NewError("validation_positive", "must not be positive")
+)
+
+// Positive is a validation rule that checks if a number is greater than zero.
+// The number can be of any integer or float type, or a big.Int, big.Float or big.Rat.
+// Like other rules, a zero value is considered empty and thus valid. Use the Required rule to reject zero.
+var Positive = SignRule{positive: true}
+
+// Negative is a validation rule that checks if a number is less than zero.
+// Please refer to Positive for the supported types and the handling of zero.
+var Negative = SignRule{negative: true}
+
+// NonNegative is a validation rule that checks if a number is not less than zero.
+// Please refer to Positive for the supported types.
+var NonNegative = SignRule{positive: true, zero: true}
+
+// NonPositive is a validation rule that checks if a number is not greater than zero.
+// Please refer to Positive for the supported types.
+var NonPositive = SignRule{negative: true, zero: true}
+
+// SignRule is a validation rule that checks the sign of a number.
+type SignRule struct {
+	positive, negative, zero bool
+	err                      Error
+}
+
+// Error sets the error message for the rule.
+func (r SignRule) Error(message string) SignRule {
+	r.err = r.defaultError().SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r SignRule) ErrorObject(err Error) SignRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r SignRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	sign, err := signOf(value)
+	if err != nil {
+		return err
+	}
+	if sign == 1 && r.positive || sign == -1 && r.negative || sign == 0 {
+		// a zero big number is treated as empty just like a zero integer or float
+		return nil
+	}
+
+	return r.defaultError()
+}
+
+// defaultError returns the error set for the rule, or the pre-defined error of its sign.
+func (r SignRule) defaultError() Error {
+	switch {
+	case r.err != nil:
+		return r.err
+	case r.positive && r.zero:
+		return ErrNegative
+	case r.negative && r.zero:
+		return ErrPositive
+	case r.negative:
+		return ErrNotNegative
+	}
+	return ErrNotPositive
+}
+
+// signOf returns -1, 0 or 1 if the given number is negative, zero or positive, respectively.
+// NaN is reported as 2 so that it fails every sign check.
+func signOf(value interface{}) (int, error) {
+	switch v := value.(type) {
+	case big.Int:
+		return v.Sign(), nil
+	case big.Float:
+		return v.Sign(), nil
+	case big.Rat:
+		return v.Sign(), nil
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return compareOrdered(rv.Int(), 0), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return compareOrdered(rv.Uint(), 0), nil
+	case reflect.Float32, reflect.Float64:
+		if math.IsNaN(rv.Float()) {
+			return 2, nil
+		}
+		return compareOrdered(rv.Float(), 0), nil
+	}
+	return 0, fmt.Errorf("type not supported: %v", rv.Type())
+}
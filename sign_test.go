@@ -0,0 +1,76 @@
+package validation
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignRules(t *testing.T) {
+	var ip *int
+	one, minusOne := 1, -1
+	tests := []struct {
+		tag   string
+		rule  SignRule
+		value interface{}
+		err   string
+	}{
+		{"t1", Positive, 1, ""},
+		{"t2", Positive, -1, "must be positive"},
+		{"t3", Positive, 0, ""},
+		{"t4", Positive, uint8(3), ""},
+		{"t5", Positive, 0.5, ""},
+		{"t6", Positive, float32(-0.5), "must be positive"},
+		{"t7", Positive, math.NaN(), "must be positive"},
+		{"t8", Positive, big.NewInt(10), ""},
+		{"t9", Positive, big.NewInt(-10), "must be positive"},
+		{"t10", Positive, big.NewFloat(-0.1), "must be positive"},
+		{"t11", Positive, big.NewRat(1, 3), ""},
+		{"t12", Positive, new(big.Int), ""},
+		{"t13", Positive, ip, ""},
+		{"t14", Positive, &one, ""},
+		{"t15", Positive, &minusOne, "must be positive"},
+		{"t16", Positive, "1", "type not supported: string"},
+		{"t17", Negative, -1, ""},
+		{"t18", Negative, 1, "must be negative"},
+		{"t19", Negative, uint(1), "must be negative"},
+		{"t20", Negative, big.NewRat(-1, 3), ""},
+		{"t21", Negative, math.Inf(-1), ""},
+		{"t22", NonNegative, 0, ""},
+		{"t23", NonNegative, 1, ""},
+		{"t24", NonNegative, -1, "must not be negative"},
+		{"t25", NonNegative, big.NewFloat(-1), "must not be negative"},
+		{"t26", NonNegative, math.NaN(), "must not be negative"},
+		{"t27", NonPositive, 0.0, ""},
+		{"t28", NonPositive, -1, ""},
+		{"t29", NonPositive, int64(1), "must not be positive"},
+		{"t30", NonPositive, big.NewInt(0), ""},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func Test_SignRule_Error(t *testing.T) {
+	r := Positive.Error("123")
+	assert.Equal(t, "123", r.Validate(-1).Error())
+	assert.Equal(t, "validation_not_positive", r.err.Code())
+	assert.Nil(t, Positive.err)
+
+	r = NonPositive.Error("abc")
+	assert.Equal(t, "validation_positive", r.err.Code())
+}
+
+func TestSignRule_ErrorObject(t *testing.T) {
+	r := Negative
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -10,11 +10,24 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 )
 
 var (
 	// ErrStructPointer is the error that a struct being validated is not specified as a pointer.
 	ErrStructPointer = errors.New("only a pointer to a struct can be validated")
+
+	// RecoverPanics specifies whether ValidateStruct, Each and ValidateValues recover from panics raised while
+	// validating a struct field, an element or a key, such as by the Validate() method of a nested Validatable.
+	// When enabled, a panic is reported as ErrPanic for the value being validated instead of being propagated. It is disabled by default so that bugs are not masked.
+	RecoverPanics = false
+
+	// ErrPanic is the error that returns for a value whose validation panics when RecoverPanics is enabled.
+	// The recovered value is available as the "panic" parameter.
+	ErrPanic = NewError("validation_panic", "internal validation error")
+
+	defaultRules   = map[reflect.Type][]Rule{}
+	defaultRulesMu sync.RWMutex
 )
 
 type (
@@ -24,10 +37,13 @@ type (
 	// ErrFieldNotFound is the error that a field cannot be found in the struct.
 	ErrFieldNotFound int
 
-	// FieldRules represents a rule set associated with a struct field.
+	// FieldRules represents a rule set associated with a struct field, or a group of such rule sets created by Rules().
 	FieldRules struct {
 		fieldPtr interface{}
 		rules    []Rule
+		group    []*FieldRules
+		isGroup  bool
+		skip     bool
 	}
 )
 
@@ -58,6 +74,10 @@ func (e ErrFieldNotFound) Error() string {
 //	fmt.Println(err)
 //	// Value: the length must be between 5 and 10.
 //
+// A field holding a nil pointer, such as an optional sub-struct, is skipped by the rules that consider
+// an empty value valid and is not validated further. If it is not nil, the value it points to is validated,
+// including by calling its Validate() method. Use the Required or NotNil rule to make sure such a field is set.
+//
 // An error will be returned if validation fails.
 func ValidateStruct(structPtr interface{}, fields ...*FieldRules) error {
 	return ValidateStructWithContext(context.Background(), structPtr, fields...)
@@ -79,24 +99,31 @@ func ValidateStructWithContext(ctx context.Context, structPtr interface{}, field
 	}
 	value = value.Elem()
 
+	// record the struct as being validated so that the fields referencing back to it do not validate it again.
+	// The struct may already be recorded if this is called by its own ValidateWithContext method.
+	ctx = recordPointer(ctx, structPtr)
+
 	errs := Errors{}
 
-	for i, fr := range fields {
+	expanded, indices := expandFieldRules(fields)
+loop:
+	for i, fr := range expanded {
+		if indices != nil {
+			i = indices[i]
+		}
 		fv := reflect.ValueOf(fr.fieldPtr)
 		if fv.Kind() != reflect.Ptr {
 			return NewInternalError(ErrFieldPointer(i))
 		}
-		ft := findStructField(value, fv)
+		ft, name := lookupStructField(value, fv)
 		if ft == nil {
 			return NewInternalError(ErrFieldNotFound(i))
 		}
-		var err error
-		if ctx == nil {
-			err = Validate(fv.Elem().Interface(), fr.rules...)
-		} else {
-			err = ValidateWithContext(ctx, fv.Elem().Interface(), fr.rules...)
+		rules := fr.rules
+		if dr := getDefaultRules(ft.Type); len(dr) > 0 {
+			rules = append(dr, rules...)
 		}
-		if err != nil {
+		if err := validateRules(ctx, fv.Elem().Interface(), rules); err != nil {
 			if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
 				return err
 			}
@@ -104,12 +131,16 @@ func ValidateStructWithContext(ctx context.Context, structPtr interface{}, field
 				// merge errors from anonymous struct field
 				if es, ok := err.(Errors); ok {
 					for name, value := range es {
-						errs[name] = value
+						if !errs.add(name, value) {
+							break loop
+						}
 					}
 					continue
 				}
 			}
-			errs[getErrorFieldName(ft)] = err
+			if !errs.add(name, err) {
+				break
+			}
 		}
 	}
 
@@ -119,6 +150,76 @@ func ValidateStructWithContext(ctx context.Context, structPtr interface{}, field
 	return nil
 }
 
+// Rules groups the rules of several struct fields into a single unit that can be passed to ValidateStruct.
+// Combined with When, it expresses an invariant across fields in a readable and reusable way, while the errors
+// are still attached to the individual fields. For example, "if the customer is a company then the company name
+// is required and the birthday must be empty" can be written as
+//
+//	func companyRules(c *Customer) *validation.FieldRules {
+//	    return validation.Rules(
+//	        validation.Field(&c.CompanyName, validation.Required),
+//	        validation.Field(&c.Birthday, validation.Empty),
+//	    ).When(c.IsCompany)
+//	}
+//
+//	err := validation.ValidateStruct(&c,
+//	    validation.Field(&c.Name, validation.Required),
+//	    companyRules(&c),
+//	)
+//
+// Groups may be nested. The fields of a group are validated in the order they are specified, just like
+// the fields passed directly to ValidateStruct.
+func Rules(fields ...*FieldRules) *FieldRules {
+	return &FieldRules{group: fields, isGroup: true}
+}
+
+// When makes the field rules, or the group of field rules created by Rules(), apply only when the condition is true.
+// When the condition is false, the fields are not validated, as if they were not specified.
+func (r *FieldRules) When(condition bool) *FieldRules {
+	fr := *r
+	fr.skip = fr.skip || !condition
+	return &fr
+}
+
+// expandFieldRules flattens the groups of field rules, leaving out those whose conditions are not met.
+// It also returns the index of the argument each of the field rules comes from, so that errors refer to the
+// arguments of ValidateStruct. The indices are nil if the field rules are returned unchanged.
+func expandFieldRules(fields []*FieldRules) ([]*FieldRules, []int) {
+	expand := false
+	for _, fr := range fields {
+		if fr.skip || fr.isGroup {
+			expand = true
+			break
+		}
+	}
+	if !expand {
+		return fields, nil
+	}
+
+	res := make([]*FieldRules, 0, len(fields))
+	indices := make([]int, 0, len(fields))
+	for i, fr := range fields {
+		res, indices = appendFieldRules(res, indices, fr, i)
+	}
+	return res, indices
+}
+
+// appendFieldRules appends the field rules, or the field rules of the group, to res, and the index of the
+// ValidateStruct argument they come from to indices.
+func appendFieldRules(res []*FieldRules, indices []int, fr *FieldRules, index int) ([]*FieldRules, []int) {
+	switch {
+	case fr.skip:
+	case fr.isGroup:
+		for _, f := range fr.group {
+			res, indices = appendFieldRules(res, indices, f, index)
+		}
+	default:
+		res = append(res, fr)
+		indices = append(indices, index)
+	}
+	return res, indices
+}
+
 // Field specifies a struct field and the corresponding validation rules.
 // The struct field must be specified as a pointer to it.
 func Field(fieldPtr interface{}, rules ...Rule) *FieldRules {
@@ -128,6 +229,36 @@ func Field(fieldPtr interface{}, rules ...Rule) *FieldRules {
 	}
 }
 
+// DefaultRulesForType registers the rules that ValidateStruct applies to every field of the given type that is
+// passed to it via Field(), before the rules explicitly specified for the field. For example,
+//
+//	validation.DefaultRulesForType(reflect.TypeOf(time.Time{}), validation.Required)
+//
+// Fields that are not passed to ValidateStruct are not validated, even if their type has default rules.
+// The field type must match the given type exactly, so a pointer type must be registered separately.
+// Calling DefaultRulesForType again for the same type replaces the rules, and calling it without rules
+// removes them. It is safe to call DefaultRulesForType concurrently with validation.
+func DefaultRulesForType(t reflect.Type, rules ...Rule) {
+	defaultRulesMu.Lock()
+	defer defaultRulesMu.Unlock()
+	if len(rules) == 0 {
+		delete(defaultRules, t)
+		return
+	}
+	defaultRules[t] = rules
+}
+
+// getDefaultRules returns a copy of the default rules registered for the given type.
+func getDefaultRules(t reflect.Type) []Rule {
+	defaultRulesMu.RLock()
+	defer defaultRulesMu.RUnlock()
+	rules := defaultRules[t]
+	if len(rules) == 0 {
+		return nil
+	}
+	return append([]Rule(nil), rules...)
+}
+
 // findStructField looks for a field in the given struct.
 // The field being looked for should be a pointer to the actual struct field.
 // If found, the field info will be returned. Otherwise, nil will be returned.
@@ -8,6 +8,7 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -213,3 +214,189 @@ func Test_getErrorFieldName(t *testing.T) {
 	assert.NotNil(t, jsonIgnoredField)
 	assert.Equal(t, "JSONIgnoredField", getErrorFieldName(jsonIgnoredField))
 }
+
+func TestDefaultRulesForType(t *testing.T) {
+	type event struct {
+		Name  string
+		Start time.Time
+		End   *time.Time
+	}
+	DefaultRulesForType(reflect.TypeOf(time.Time{}), Required)
+	defer DefaultRulesForType(reflect.TypeOf(time.Time{}))
+
+	e := event{}
+	err := ValidateStruct(&e, Field(&e.Name), Field(&e.Start), Field(&e.End))
+	assert.EqualError(t, err, "Start: cannot be blank.")
+
+	e.Start = time.Now()
+	err = ValidateStruct(&e, Field(&e.Name), Field(&e.Start, Max(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))))
+	assert.EqualError(t, err, "Start: must be no greater than 2000-01-01 00:00:00 +0000 UTC.")
+
+	// explicit rules follow the default ones
+	e.Start = time.Time{}
+	err = ValidateStruct(&e, Field(&e.Start, Skip))
+	assert.EqualError(t, err, "Start: cannot be blank.")
+
+	// fields not passed to ValidateStruct are not validated
+	assert.Nil(t, ValidateStruct(&e, Field(&e.Name)))
+
+	DefaultRulesForType(reflect.TypeOf(time.Time{}))
+	assert.Nil(t, ValidateStruct(&e, Field(&e.Start)))
+}
+
+type optionalTLSConfig struct {
+	CertFile string
+}
+
+func (c optionalTLSConfig) Validate() error {
+	return ValidateStruct(&c, Field(&c.CertFile, Required))
+}
+
+type optionalDBConfig struct {
+	Host string
+	TLS  *optionalTLSConfig
+}
+
+func (c *optionalDBConfig) Validate() error {
+	return ValidateStruct(c, Field(&c.Host, Required), Field(&c.TLS))
+}
+
+func TestValidateStruct_OptionalPointers(t *testing.T) {
+	type config struct {
+		DB    *optionalDBConfig
+		Cache **optionalDBConfig
+	}
+	cache := &optionalDBConfig{}
+	tests := []struct {
+		tag    string
+		config config
+		rules  func(c *config) []*FieldRules
+		err    string
+	}{
+		{"t1", config{}, func(c *config) []*FieldRules { return []*FieldRules{Field(&c.DB), Field(&c.Cache)} }, ""},
+		{"t2", config{DB: &optionalDBConfig{Host: "db"}}, func(c *config) []*FieldRules { return []*FieldRules{Field(&c.DB)} }, ""},
+		{"t3", config{DB: &optionalDBConfig{}}, func(c *config) []*FieldRules { return []*FieldRules{Field(&c.DB)} }, "DB: (Host: cannot be blank.)."},
+		{"t4", config{DB: &optionalDBConfig{Host: "db", TLS: &optionalTLSConfig{}}}, func(c *config) []*FieldRules { return []*FieldRules{Field(&c.DB)} }, "DB: (TLS: (CertFile: cannot be blank.).)."},
+		{"t5", config{Cache: &cache}, func(c *config) []*FieldRules { return []*FieldRules{Field(&c.Cache)} }, "Cache: (Host: cannot be blank.)."},
+		{"t6", config{}, func(c *config) []*FieldRules { return []*FieldRules{Field(&c.DB, Required)} }, "DB: cannot be blank."},
+		{"t7", config{DB: &optionalDBConfig{}}, func(c *config) []*FieldRules { return []*FieldRules{Field(&c.DB, Required)} }, "DB: (Host: cannot be blank.)."},
+	}
+	for _, test := range tests {
+		c := test.config
+		err := ValidateStruct(&c, test.rules(&c)...)
+		assertError(t, test.err, err, test.tag)
+		err = ValidateStructWithContext(context.Background(), &c, test.rules(&c)...)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+type panickingModel struct{}
+
+func (m panickingModel) Validate() error {
+	panic("oops")
+}
+
+func TestValidateStruct_RecoverPanics(t *testing.T) {
+	s := struct {
+		Name  string
+		Model panickingModel
+	}{}
+
+	assert.Panics(t, func() {
+		_ = ValidateStruct(&s, Field(&s.Name, Required), Field(&s.Model))
+	})
+
+	RecoverPanics = true
+	defer func() { RecoverPanics = false }()
+
+	err := ValidateStruct(&s, Field(&s.Name, Required), Field(&s.Model))
+	assert.EqualError(t, err, "Model: internal validation error; Name: cannot be blank.")
+	if es, ok := err.(Errors); assert.True(t, ok) {
+		assert.Equal(t, "oops", es["Model"].(Error).Params()["panic"])
+	}
+
+	err = ValidateStructWithContext(context.Background(), &s, Field(&s.Model))
+	assert.EqualError(t, err, "Model: internal validation error.")
+
+	err = ValidateStruct(&s, Field(&s.Name, By(func(interface{}) error { panic("rule") })))
+	assert.EqualError(t, err, "Name: internal validation error.")
+}
+
+func TestRules(t *testing.T) {
+	type customer struct {
+		Name        string
+		IsCompany   bool
+		CompanyName string
+		Birthday    string
+	}
+	companyRules := func(c *customer) *FieldRules {
+		return Rules(
+			Field(&c.CompanyName, Required),
+			Field(&c.Birthday, Empty),
+		).When(c.IsCompany)
+	}
+
+	tests := []struct {
+		tag   string
+		model customer
+		err   string
+	}{
+		{"t1", customer{Name: "a", Birthday: "2000-01-01"}, ""},
+		{"t2", customer{Name: "a", IsCompany: true, Birthday: "2000-01-01"}, "Birthday: must be blank; CompanyName: cannot be blank."},
+		{"t3", customer{Name: "a", IsCompany: true, CompanyName: "b"}, ""},
+		{"t4", customer{IsCompany: true, CompanyName: "b"}, "Name: cannot be blank."},
+	}
+	for _, test := range tests {
+		c := test.model
+		err := ValidateStruct(&c,
+			Field(&c.Name, Required),
+			companyRules(&c),
+		)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	// nested groups and field conditions
+	c := customer{IsCompany: true}
+	err := ValidateStruct(&c,
+		Rules(
+			Field(&c.Name, Required).When(false),
+			Rules(Field(&c.CompanyName, Required)),
+		).When(c.IsCompany),
+	)
+	assertError(t, "CompanyName: cannot be blank.", err, "t5")
+
+	err = ValidateStruct(&c, Rules(), Rules(Field(&c.Name, Required)).When(true).When(false))
+	assertError(t, "", err, "t6")
+
+	err = ValidateStructWithContext(context.Background(), &c, Rules(Field(c.Name, Required)))
+	assertError(t, "field #0 must be specified as a pointer", err, "t7")
+
+	// the errors refer to the arguments of ValidateStruct rather than to the expanded fields
+	other := customer{}
+	err = ValidateStruct(&c,
+		Rules(Field(&c.Name), Field(&c.CompanyName)),
+		Field(&c.Birthday),
+		Field(c.Name),
+	)
+	assertError(t, "field #2 must be specified as a pointer", err, "t8")
+	err = ValidateStruct(&c,
+		Field(&c.Name),
+		Rules(Field(&c.Name), Rules(Field(&other.Name))),
+	)
+	assertError(t, "field #1 cannot be found in the struct", err, "t9")
+}
+
+func BenchmarkValidateStruct(b *testing.B) {
+	b.ReportAllocs()
+	s := Struct1{Field1: 1, JSONField: 2, Struct2: Struct2{Field21: "a", Field22: "b"}}
+	for i := 0; i < b.N; i++ {
+		_ = ValidateStruct(&s,
+			Field(&s.Field1, Required),
+			Field(&s.Field3),
+			Field(&s.Field21, Required),
+			Field(&s.Field22, Required),
+			Field(&s.S2),
+			Field(&s.JSONField, Required),
+		)
+	}
+}
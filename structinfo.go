@@ -0,0 +1,81 @@
+package validation
+
+import (
+	"reflect"
+	"sync"
+)
+
+// structInfo holds the reflection metadata of a struct type needed to locate its fields by address.
+type structInfo struct {
+	// fields maps the offsets and types of the fields, including the promoted fields of embedded structs,
+	// to their metadata.
+	fields map[fieldKey]*structFieldInfo
+	// dynamic indicates that the struct embeds struct pointers, whose fields cannot be located by offset.
+	dynamic bool
+}
+
+// structFieldInfo holds the metadata of a struct field.
+type structFieldInfo struct {
+	field reflect.StructField
+	name  string
+}
+
+// structInfoKey identifies the cached metadata of a struct type. The error tag is part of the key, as the error
+// names of the fields depend on it.
+type structInfoKey struct {
+	typ      reflect.Type
+	errorTag string
+}
+
+// structInfos caches the metadata of the struct types validated by ValidateStruct.
+var structInfos sync.Map
+
+// getStructInfo returns the metadata of the given struct type, building and caching it on first use.
+func getStructInfo(t reflect.Type) *structInfo {
+	key := structInfoKey{t, ErrorTag}
+	if si, ok := structInfos.Load(key); ok {
+		return si.(*structInfo)
+	}
+	si := &structInfo{fields: map[fieldKey]*structFieldInfo{}}
+	si.collect(t, 0)
+	actual, _ := structInfos.LoadOrStore(key, si)
+	return actual.(*structInfo)
+}
+
+// collect adds the fields of a struct type located at the given offset. The fields are visited in the same order
+// as findStructField does, so that a field sharing its offset and type with another one resolves the same way.
+func (si *structInfo) collect(t reflect.Type, offset uintptr) {
+	for i := t.NumField() - 1; i >= 0; i-- {
+		sf := t.Field(i)
+		key := fieldKey{offset + sf.Offset, sf.Type}
+		if _, ok := si.fields[key]; !ok {
+			si.fields[key] = &structFieldInfo{field: sf, name: getErrorFieldName(&sf)}
+		}
+		if sf.Anonymous {
+			if sf.Type.Kind() == reflect.Struct {
+				si.collect(sf.Type, offset+sf.Offset)
+			} else if sf.Type.Kind() == reflect.Ptr && sf.Type.Elem().Kind() == reflect.Struct {
+				si.dynamic = true
+			}
+		}
+	}
+}
+
+// lookupStructField looks for a field in the given addressable struct using the cached metadata of the struct type.
+// The field being looked for should be a pointer to the actual struct field. If found, the field info and
+// the name used to represent its validation error are returned. Otherwise, nil will be returned.
+func lookupStructField(structValue reflect.Value, fieldValue reflect.Value) (*reflect.StructField, string) {
+	si := getStructInfo(structValue.Type())
+	ptr, base := fieldValue.Pointer(), structValue.UnsafeAddr()
+	if ptr >= base && ptr-base <= structValue.Type().Size() {
+		if f, ok := si.fields[fieldKey{ptr - base, fieldValue.Elem().Type()}]; ok {
+			return &f.field, f.name
+		}
+	}
+	if si.dynamic {
+		if f := findStructField(structValue, fieldValue); f != nil {
+			return f, getErrorFieldName(f)
+		}
+	}
+	return nil, ""
+}
@@ -0,0 +1,67 @@
+package validation
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupStructField(t *testing.T) {
+	var s1 Struct1
+	v1 := reflect.ValueOf(&s1).Elem()
+	fields := []interface{}{
+		&s1.Field1, s1.Field2, &s1.Field2, &s1.Field3, &s1.Field4, &s1.field5, &s1.Struct2, &s1.S1, &s1.S2,
+		&s1.Field21, &s1.Field22, &s1.S2.Field21, &s1.JSONField, &s1.JSONIgnoredField,
+	}
+	for i, field := range fields {
+		fv := reflect.ValueOf(field)
+		expected := findStructField(v1, fv)
+		actual, name := lookupStructField(v1, fv)
+		assert.Equal(t, expected, actual, i)
+		if expected != nil {
+			assert.Equal(t, getErrorFieldName(expected), name, i)
+		}
+	}
+
+	other := 1
+	f, _ := lookupStructField(v1, reflect.ValueOf(&other))
+	assert.Nil(t, f)
+
+	s3 := Struct3{Struct2: &Struct2{}}
+	v3 := reflect.ValueOf(&s3).Elem()
+	f, name := lookupStructField(v3, reflect.ValueOf(&s3.Struct2))
+	if assert.NotNil(t, f) {
+		assert.Equal(t, "Struct2", name)
+	}
+	f, name = lookupStructField(v3, reflect.ValueOf(&s3.Field21))
+	if assert.NotNil(t, f) {
+		assert.Equal(t, "Field21", name)
+	}
+}
+
+func TestLookupStructField_ErrorTag(t *testing.T) {
+	var s1 Struct1
+	v1 := reflect.ValueOf(&s1).Elem()
+	_, name := lookupStructField(v1, reflect.ValueOf(&s1.JSONField))
+	assert.Equal(t, "some_json_field", name)
+
+	ErrorTag = "yaml"
+	defer func() { ErrorTag = "json" }()
+	_, name = lookupStructField(v1, reflect.ValueOf(&s1.JSONField))
+	assert.Equal(t, "JSONField", name)
+}
+
+func TestLookupStructField_ZeroSize(t *testing.T) {
+	var s struct {
+		A struct{}
+		B struct{}
+		C int
+	}
+	v := reflect.ValueOf(&s).Elem()
+	for _, field := range []interface{}{&s.A, &s.B, &s.C} {
+		fv := reflect.ValueOf(field)
+		f, _ := lookupStructField(v, fv)
+		assert.Equal(t, findStructField(v, fv), f)
+	}
+}
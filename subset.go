@@ -0,0 +1,101 @@
+package validation
+
+var (
+	// ErrNotSubset is the error that returns when a slice contains values that are not allowed.
+	ErrNotSubset = NewError("validation_not_subset", "contains values that are not allowed")
+	// ErrNotSuperset is the error that returns when a slice does not include all the required values.
+	ErrNotSuperset = NewError("validation_not_superset", "must include all required values")
+)
+
+// SetRule is a validation rule that checks if the elements of a slice or array form a subset or a superset
+// of the given values.
+type SetRule[T any] struct {
+	values   []T
+	superset bool
+	eq       func(a, b interface{}) bool
+	err      Error
+}
+
+// SubsetOf returns a validation rule that checks if every element of a slice or array is one of the allowed values,
+// e.g. to validate a list of tags or permissions against a policy. The elements that are not allowed are reported
+// as the "values" parameter of the error, in the order they appear.
+// Values are compared in the same way as the In rule does, unless a function is set with EqualFunc.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func SubsetOf[T any](allowed ...T) SetRule[T] {
+	return SetRule[T]{values: allowed, err: ErrNotSubset}
+}
+
+// SupersetOf returns a validation rule that checks if a slice or array includes all the required values.
+// The missing values are reported as the "missing" parameter of the error.
+// Values are compared in the same way as the In rule does, unless a function is set with EqualFunc.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func SupersetOf[T any](required ...T) SetRule[T] {
+	return SetRule[T]{values: required, superset: true, err: ErrNotSuperset}
+}
+
+// EqualFunc sets the function used to determine if an element equals one of the values, as InFunc does for In.
+// The function is called with an element of the slice as the first argument and one of the values as the second one.
+// Elements are indirected before being compared, so a pointer is compared by the value it references.
+func (r SetRule[T]) EqualFunc(eq func(a, b interface{}) bool) SetRule[T] {
+	r.eq = eq
+	return r
+}
+
+// Error sets the error message for the rule.
+func (r SetRule[T]) Error(message string) SetRule[T] {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r SetRule[T]) ErrorObject(err Error) SetRule[T] {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r SetRule[T]) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	elements, err := sliceElements(value)
+	if err != nil {
+		return err
+	}
+
+	if r.superset {
+		var missing []T
+		for _, v := range r.values {
+			if !containsElement(elements, v, r.eq) {
+				missing = append(missing, v)
+			}
+		}
+		if len(missing) > 0 {
+			return r.err.SetParams(map[string]interface{}{"missing": missing})
+		}
+		return nil
+	}
+
+	var invalid []interface{}
+	for _, e := range elements {
+		if !r.allows(e) {
+			invalid = append(invalid, e)
+		}
+	}
+	if len(invalid) > 0 {
+		return r.err.SetParams(map[string]interface{}{"values": invalid})
+	}
+	return nil
+}
+
+// allows checks if the given element equals one of the values of the rule.
+func (r SetRule[T]) allows(e interface{}) bool {
+	for _, v := range r.values {
+		if r.eq != nil && r.eq(e, v) || r.eq == nil && equalValues(v, e) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubsetOf(t *testing.T) {
+	type perm int
+	read := "read"
+	var tags []string
+	tests := []struct {
+		tag   string
+		rule  Rule
+		value interface{}
+		err   string
+	}{
+		{"t1", SubsetOf("read", "write"), []string{"read"}, ""},
+		{"t2", SubsetOf("read", "write"), []string{"write", "read", "read"}, ""},
+		{"t3", SubsetOf("read", "write"), []string{"read", "delete"}, "contains values that are not allowed"},
+		{"t4", SubsetOf("read"), []*string{&read, nil}, ""},
+		{"t5", SubsetOf(1, 2), []perm{1, 2}, ""},
+		{"t6", SubsetOf[string](), []string{"a"}, "contains values that are not allowed"},
+		{"t7", SupersetOf("read", "write"), []string{"write", "admin", "read"}, ""},
+		{"t8", SupersetOf("read", "write"), []string{"read"}, "must include all required values"},
+		{"t9", SupersetOf[string](), []string{"a"}, ""},
+		{"t10", SubsetOf("a"), []string{}, ""},
+		{"t11", SupersetOf("a"), tags, ""},
+		{"t12", SubsetOf("a"), "a", "must be a slice or an array"},
+	}
+
+	for _, test := range tests {
+		err := Validate(test.value, test.rule)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestSetRule_Params(t *testing.T) {
+	err := SubsetOf("read", "write").Validate([]string{"delete", "read", "admin"})
+	if assert.NotNil(t, err) {
+		assert.Equal(t, []interface{}{"delete", "admin"}, err.(Error).Params()["values"])
+	}
+
+	err = SupersetOf("read", "write", "admin").Validate([]string{"write"})
+	if assert.NotNil(t, err) {
+		assert.Equal(t, []string{"read", "admin"}, err.(Error).Params()["missing"])
+	}
+}
+
+func TestSetRule_EqualFunc(t *testing.T) {
+	fold := func(a, b interface{}) bool {
+		return strings.EqualFold(a.(string), b.(string))
+	}
+	assert.Nil(t, SubsetOf("read").EqualFunc(fold).Validate([]string{"READ"}))
+	assert.NotNil(t, SubsetOf("read").Validate([]string{"READ"}))
+	assert.Nil(t, SupersetOf("read").EqualFunc(fold).Validate([]string{"Read"}))
+}
+
+func Test_SetRule_Error(t *testing.T) {
+	r := SubsetOf("a")
+	assert.Equal(t, "contains values that are not allowed", r.err.Message())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+	assert.Equal(t, "validation_not_superset", SupersetOf("a").err.Code())
+}
+
+func TestSetRule_ErrorObject(t *testing.T) {
+	r := SubsetOf("a")
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,292 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// TagRuleFunc creates a validation rule for a token of a validation tag.
+// The type is that of the struct field being validated, and the param is the part of the token
+// after "=" (empty if the token has no parameter).
+type TagRuleFunc func(t reflect.Type, param string) (Rule, error)
+
+var (
+	// ValidationTag is the struct tag name used by ValidateTagged to declare the validation rules of a struct field.
+	ValidationTag = "validate"
+
+	tagRules = map[string]TagRuleFunc{
+		"required": requiredTagRule,
+		"min":      minTagRule,
+		"max":      maxTagRule,
+		"len":      lenTagRule,
+	}
+	tagRulesMu sync.RWMutex
+
+	namedRules   = map[string]Rule{}
+	namedRulesMu sync.RWMutex
+
+	timeType = reflect.TypeOf(time.Time{})
+)
+
+// RegisterTagRule registers a validation tag token so that it can be used with ValidateTagged.
+// Registering an existing token replaces it. The "email" and "url" tokens are registered by the "is" package.
+func RegisterTagRule(name string, f TagRuleFunc) {
+	tagRulesMu.Lock()
+	defer tagRulesMu.Unlock()
+	tagRules[name] = f
+}
+
+// RegisterRule registers a rule under the given name so that it can be referenced by a "rule:NAME" token
+// in validation tags. This allows project-specific rules to be used with ValidateTagged, e.g.
+//
+//	validation.RegisterRule("sku", validation.Match(regexp.MustCompile(`^[A-Z]{3}-[0-9]{4}$`)))
+//
+//	type Product struct {
+//	    SKU string `validate:"required,rule:sku"`
+//	}
+//
+// Registering an existing name replaces it.
+func RegisterRule(name string, rule Rule) {
+	namedRulesMu.Lock()
+	defer namedRulesMu.Unlock()
+	namedRules[name] = rule
+}
+
+// ValidateTagged validates a struct by checking its exported fields against the rules declared in their
+// validation tags. For example,
+//
+//	type User struct {
+//	    Name  string `validate:"required,max=50"`
+//	    Email string `validate:"required,email"`
+//	    Age   int    `validate:"min=18"`
+//	}
+//	err := validation.ValidateTagged(&user)
+//
+// The tag holds a comma-separated list of tokens, each of which may take a parameter after "=".
+// The following tokens are supported by default:
+//   - required: the Required rule
+//   - min, max: the Min and Max rules for numbers, or the minimum and maximum length for strings, slices,
+//     maps and arrays (rune length is checked for strings)
+//   - len: the exact length for strings, slices, maps and arrays
+//   - email, url: the is.EmailFormat and is.URL rules, which are only available if package
+//     github.com/aboozaid/validation/is is imported
+//   - rule:NAME: the rule registered under NAME with RegisterRule
+//
+// The lengths of min, max and len must be positive; use RegisterRule with Empty to require an empty value.
+// Like other rules, min, max and len consider an empty value valid. Use required to make sure a value is not empty.
+//
+// Use RegisterTagRule to support more tokens. Nested struct fields are validated recursively unless their
+// tag is "-", and fields implementing Validatable are validated by calling their Validate() method.
+// Like ValidateStruct, the struct must be specified as a pointer to it.
+func ValidateTagged(structPtr interface{}) error {
+	return ValidateTaggedWithContext(context.Background(), structPtr)
+}
+
+// ValidateTaggedWithContext validates a struct with the given context using the rules declared in its validation tags.
+// Please refer to ValidateTagged for the detailed instructions on how to use this function.
+func ValidateTaggedWithContext(ctx context.Context, structPtr interface{}) error {
+	value := reflect.ValueOf(structPtr)
+	if value.Kind() != reflect.Ptr || !value.IsNil() && value.Elem().Kind() != reflect.Struct {
+		// must be a pointer to a struct
+		return NewInternalError(ErrStructPointer)
+	}
+	if value.IsNil() {
+		// treat a nil struct pointer as valid
+		return nil
+	}
+
+	fields, err := taggedFields(value.Elem())
+	if err != nil {
+		return NewInternalError(err)
+	}
+	return ValidateStructWithContext(ctx, structPtr, fields...)
+}
+
+// taggedFields builds the field rules of a struct from the validation tags of its fields.
+func taggedFields(sv reflect.Value) ([]*FieldRules, error) {
+	st := sv.Type()
+	fields := make([]*FieldRules, 0, st.NumField())
+	for i := 0; i < st.NumField(); i++ {
+		sf := st.Field(i)
+		tag := sf.Tag.Get(ValidationTag)
+		if tag == "-" {
+			continue
+		}
+		if !sf.IsExported() {
+			if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+				// the exported fields of an embedded struct of an unexported type are promoted
+				fs, err := taggedFields(sv.Field(i))
+				if err != nil {
+					return nil, err
+				}
+				fields = append(fields, fs...)
+			}
+			continue
+		}
+		rules, err := parseValidationTag(sf.Type, tag)
+		if err != nil {
+			return nil, fmt.Errorf("field %v: %w", sf.Name, err)
+		}
+		if isNestedStruct(sf.Type) {
+			rules = append(rules, WithContext(validateNestedTagged))
+		}
+		if len(rules) > 0 || sf.Type.Implements(validatableType) || sf.Type.Implements(validatableWithContextType) {
+			fields = append(fields, Field(sv.Field(i).Addr().Interface(), rules...))
+		}
+	}
+	return fields, nil
+}
+
+// isNestedStruct checks if a field of the given type should be validated recursively by ValidateTagged.
+func isNestedStruct(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct && t != timeType &&
+		!t.Implements(validatableType) && !reflect.PtrTo(t).Implements(validatableType)
+}
+
+// validateNestedTagged validates a nested struct or pointer to struct using its validation tags.
+func validateNestedTagged(ctx context.Context, value interface{}) error {
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Ptr {
+		ctx, ok := enterPointer(ctx, value)
+		if !ok {
+			// the struct references back to a struct being validated
+			return nil
+		}
+		return ValidateTaggedWithContext(ctx, value)
+	}
+	// copy the struct so that it is addressable
+	ptr := reflect.New(rv.Type())
+	ptr.Elem().Set(rv)
+	return ValidateTaggedWithContext(ctx, ptr.Interface())
+}
+
+// parseValidationTag converts a validation tag into the corresponding rules.
+func parseValidationTag(t reflect.Type, tag string) ([]Rule, error) {
+	if tag == "" {
+		return nil, nil
+	}
+	tagRulesMu.RLock()
+	defer tagRulesMu.RUnlock()
+
+	var rules []Rule
+	for _, token := range strings.Split(tag, ",") {
+		name, param, _ := strings.Cut(strings.TrimSpace(token), "=")
+		if name == "" {
+			continue
+		}
+		if ruleName, ok := strings.CutPrefix(name, "rule:"); ok {
+			rule, err := lookupNamedRule(ruleName)
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, rule)
+			continue
+		}
+		f, ok := tagRules[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown validation tag %q", name)
+		}
+		rule, err := f(t, param)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validation tag %q: %w", token, err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// lookupNamedRule returns the rule registered under the given name by RegisterRule.
+func lookupNamedRule(name string) (Rule, error) {
+	namedRulesMu.RLock()
+	defer namedRulesMu.RUnlock()
+	rule, ok := namedRules[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown named rule %q", name)
+	}
+	return rule, nil
+}
+
+func requiredTagRule(reflect.Type, string) (Rule, error) {
+	return Required, nil
+}
+
+func minTagRule(t reflect.Type, param string) (Rule, error) {
+	return thresholdTagRule(t, param, true)
+}
+
+func maxTagRule(t reflect.Type, param string) (Rule, error) {
+	return thresholdTagRule(t, param, false)
+}
+
+// thresholdTagRule creates the rule for a "min" or "max" token according to the kind of the field.
+func thresholdTagRule(t reflect.Type, param string, isMin bool) (Rule, error) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	var threshold interface{}
+	var err error
+	switch t.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		n, err := parseTagLength(param)
+		if err != nil {
+			return nil, err
+		}
+		if isMin {
+			return RuneLength(n, 0), nil
+		}
+		return RuneLength(0, n), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		threshold, err = strconv.ParseInt(param, 10, 64)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		threshold, err = strconv.ParseUint(param, 10, 64)
+	case reflect.Float32, reflect.Float64:
+		threshold, err = strconv.ParseFloat(param, 64)
+	default:
+		return nil, fmt.Errorf("type not supported: %v", t)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if isMin {
+		return Min(threshold), nil
+	}
+	return Max(threshold), nil
+}
+
+func lenTagRule(t reflect.Type, param string) (Rule, error) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+	default:
+		return nil, fmt.Errorf("type not supported: %v", t)
+	}
+	n, err := parseTagLength(param)
+	if err != nil {
+		return nil, err
+	}
+	return RuneLength(n, n), nil
+}
+
+// parseTagLength parses the length of a "min", "max" or "len" token.
+// The length must be positive, because the length rule built from a zero bound would require an empty value.
+func parseTagLength(param string) (int, error) {
+	n, err := strconv.Atoi(param)
+	if err != nil {
+		return 0, err
+	}
+	if n <= 0 {
+		return 0, fmt.Errorf("invalid length: %v", n)
+	}
+	return n, nil
+}
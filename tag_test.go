@@ -0,0 +1,159 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type taggedAddress struct {
+	Zip string `json:"zip" validate:"required,len=5"`
+}
+
+type taggedModel struct {
+	taggedAddress
+	Name     string            `json:"name" validate:"required,max=5"`
+	Nick     string            `validate:"min=2"`
+	Age      int               `validate:"min=18,max=150"`
+	Score    *float64          `validate:"max=10"`
+	Count    uint8             `validate:"max=3"`
+	Tags     []string          `validate:"max=2"`
+	Attrs    map[string]string `validate:"len=1"`
+	Address  taggedAddress     `json:"address"`
+	AddrPtr  *taggedAddress
+	Created  time.Time     `validate:"required"`
+	Ignored  taggedAddress `validate:"-"`
+	Model3   Model3
+	internal string `validate:"required"`
+}
+
+func TestValidateTagged(t *testing.T) {
+	score := 11.0
+	valid := func() taggedModel {
+		return taggedModel{
+			taggedAddress: taggedAddress{Zip: "12345"},
+			Name:          "abc",
+			Age:           20,
+			Attrs:         map[string]string{"a": "b"},
+			Address:       taggedAddress{Zip: "12345"},
+			Created:       time.Now(),
+			Model3:        Model3{A: "abc"},
+		}
+	}
+
+	m := valid()
+	assert.Nil(t, ValidateTagged(&m))
+
+	m = valid()
+	m.Name = "abcdef"
+	m.Nick = "a"
+	m.Age = 10
+	m.Score = &score
+	m.Count = 4
+	m.Tags = []string{"a", "b", "c"}
+	m.Attrs = map[string]string{}
+	m.Created = time.Time{}
+	assert.EqualError(t, ValidateTagged(&m), "Age: must be no less than 18; Count: must be no greater than 3; Created: cannot be blank; Nick: the length must be no less than 2; Score: must be no greater than 10; Tags: the length must be no more than 2; name: the length must be no more than 5.")
+
+	m = valid()
+	m.Zip = ""
+	m.Address.Zip = "123"
+	m.AddrPtr = &taggedAddress{Zip: "1"}
+	m.Ignored.Zip = "1"
+	m.Model3.A = "xyz"
+	assert.EqualError(t, ValidateTagged(&m), "AddrPtr: (zip: the length must be exactly 5.); Model3: (A: error abc.); address: (zip: the length must be exactly 5.); zip: cannot be blank.")
+
+	var nilModel *taggedModel
+	assert.Nil(t, ValidateTagged(nilModel))
+	assert.Equal(t, NewInternalError(ErrStructPointer), ValidateTagged(m))
+}
+
+func TestValidateTagged_InvalidTags(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", &struct {
+			A string `validate:"unknown"`
+		}{}, `field A: unknown validation tag "unknown"`},
+		{"t2", &struct {
+			A int `validate:"min=abc"`
+		}{}, `field A: invalid validation tag "min=abc": strconv.ParseInt: parsing "abc": invalid syntax`},
+		{"t3", &struct {
+			A bool `validate:"max=1"`
+		}{}, `field A: invalid validation tag "max=1": type not supported: bool`},
+		{"t4", &struct {
+			A int `validate:"len=1"`
+		}{}, `field A: invalid validation tag "len=1": type not supported: int`},
+		{"t5", &struct {
+			A string `validate:"rule:unknown"`
+		}{}, `field A: unknown named rule "unknown"`},
+		{"t6", &struct {
+			A string `validate:"max=0"`
+		}{}, `field A: invalid validation tag "max=0": invalid length: 0`},
+		{"t7", &struct {
+			A []int `validate:"len=0"`
+		}{}, `field A: invalid validation tag "len=0": invalid length: 0`},
+		{"t8", &struct {
+			A string `validate:"min=-1"`
+		}{}, `field A: invalid validation tag "min=-1": invalid length: -1`},
+		{"t9", &struct {
+			A []string `validate:"min=0"`
+		}{}, `field A: invalid validation tag "min=0": invalid length: 0`},
+	}
+
+	for _, test := range tests {
+		err := ValidateTagged(test.value)
+		if assert.NotNil(t, err, test.tag) {
+			_, ok := err.(InternalError)
+			assert.True(t, ok, test.tag)
+			assert.EqualError(t, err, test.err, test.tag)
+		}
+	}
+}
+
+func TestRegisterTagRule(t *testing.T) {
+	RegisterTagRule("abc", func(reflect.Type, string) (Rule, error) {
+		return &validateAbc{}, nil
+	})
+	RegisterTagRule("fail", func(reflect.Type, string) (Rule, error) {
+		return nil, errors.New("fail")
+	})
+	defer func() {
+		delete(tagRules, "abc")
+		delete(tagRules, "fail")
+	}()
+
+	m := struct {
+		A string `validate:"abc"`
+	}{"xyz"}
+	assert.EqualError(t, ValidateTagged(&m), "A: error abc.")
+
+	m2 := struct {
+		A string `validate:"fail"`
+	}{}
+	assert.EqualError(t, ValidateTagged(&m2), `field A: invalid validation tag "fail": fail`)
+}
+
+func TestRegisterRule(t *testing.T) {
+	RegisterRule("abc", &validateAbc{})
+	RegisterRule("short", Length(0, 3))
+	defer func() {
+		delete(namedRules, "abc")
+		delete(namedRules, "short")
+	}()
+
+	m := struct {
+		A string `validate:"rule:abc"`
+		B string `validate:"required, rule:short"`
+		C string `validate:"rule:short,rule:abc"`
+	}{"xyz", "", "abcd"}
+	assert.EqualError(t, ValidateTagged(&m), "A: error abc; B: cannot be blank; C: the length must be no more than 3.")
+
+	m.A, m.B, m.C = "abc", "abc", "abc"
+	assert.Nil(t, ValidateTagged(&m))
+}
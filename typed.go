@@ -0,0 +1,201 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"time"
+)
+
+type (
+	// TypedRule represents a validation rule for values of type T.
+	// Unlike Rule, applying a TypedRule to a value of a different type is caught at compile time.
+	TypedRule[T any] interface {
+		// Check validates a value and returns an error if validation fails.
+		Check(value T) error
+	}
+
+	// TypedRuleWithContext represents a context-aware validation rule for values of type T.
+	TypedRuleWithContext[T any] interface {
+		// CheckWithContext validates a value with the given context and returns an error if validation fails.
+		CheckWithContext(ctx context.Context, value T) error
+	}
+
+	// TypedRuleFunc represents a validator function for values of type T.
+	// You may wrap it as a TypedRule by calling TypedBy().
+	TypedRuleFunc[T any] func(value T) error
+
+	// TypedRuleWithContextFunc represents a context-aware validator function for values of type T.
+	// You may wrap it as a TypedRule by calling TypedWithContext().
+	TypedRuleWithContextFunc[T any] func(ctx context.Context, value T) error
+)
+
+// Check validates the given value using the typed rules and returns the validation error, if any.
+// It behaves the same as Validate except that the rules are checked against the type of the value at compile time.
+// For example,
+//
+//	err := validation.Check("abc",
+//	    validation.Typed[string](validation.Required),
+//	    validation.TypedBy(func(s string) error {
+//	        if !strings.HasPrefix(s, "a") {
+//	            return errors.New("must start with a")
+//	        }
+//	        return nil
+//	    }),
+//	)
+func Check[T any](value T, rules ...TypedRule[T]) error {
+	return Validate(value, Untyped(rules...)...)
+}
+
+// CheckWithContext validates the given value with the given context using the typed rules.
+// It behaves the same as ValidateWithContext except that the rules are checked against the type of the value at compile time.
+func CheckWithContext[T any](ctx context.Context, value T, rules ...TypedRule[T]) error {
+	return ValidateWithContext(ctx, value, Untyped(rules...)...)
+}
+
+// Typed adapts a Rule into a TypedRule for values of type T.
+// This allows the existing rules, such as Required or Length, to be used with Check.
+// Note that Typed only binds the rule to T: whether the rule supports values of type T is still only known
+// when validating, e.g. Typed[bool](Length(1, 5)) compiles but reports an error for every non-empty value.
+// Prefer the typed constructors, such as LengthOf or MinOf, which restrict T to the types the rule supports.
+func Typed[T any](rule Rule) TypedRule[T] {
+	return typedRule[T]{rule: rule}
+}
+
+// Untyped converts typed rules into rules that can be used with Validate, ValidateStruct, Each, etc.
+// An untyped rule reports an error if the value being validated is not of type T.
+func Untyped[T any](rules ...TypedRule[T]) []Rule {
+	rs := make([]Rule, len(rules))
+	for i, rule := range rules {
+		if tr, ok := rule.(typedRule[T]); ok {
+			rs[i] = tr.rule
+		} else {
+			rs[i] = untypedRule[T]{rule: rule}
+		}
+	}
+	return rs
+}
+
+// TypedBy wraps a TypedRuleFunc into a TypedRule.
+func TypedBy[T any](f TypedRuleFunc[T]) TypedRule[T] {
+	return &inlineTypedRule[T]{f: f}
+}
+
+// TypedWithContext wraps a TypedRuleWithContextFunc into a context-aware TypedRule.
+func TypedWithContext[T any](f TypedRuleWithContextFunc[T]) TypedRule[T] {
+	return &inlineTypedRule[T]{fc: f}
+}
+
+// Threshold is the set of types supported by the thresholds of MinOf and MaxOf.
+type Threshold interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		time.Time
+}
+
+// TypedField specifies a struct field and the typed rules for validating it with ValidateStruct.
+// Unlike Field, the rules are checked against the type of the field at compile time. For example,
+//
+//	validation.TypedField(&c.Age, validation.MinOf(18), validation.MaxOf(130))
+func TypedField[T any](fieldPtr *T, rules ...TypedRule[T]) *FieldRules {
+	return Field(fieldPtr, Untyped(rules...)...)
+}
+
+// RequiredOf returns the Required rule for values of type T.
+func RequiredOf[T any]() TypedRule[T] {
+	return Typed[T](Required)
+}
+
+// LengthOf returns the Length rule for strings and byte slices of type T.
+// Use Typed with Length to set a custom error message.
+func LengthOf[T ~string | ~[]byte](min, max int) TypedRule[T] {
+	return Typed[T](Length(min, max))
+}
+
+// RuneLengthOf returns the RuneLength rule for strings of type T.
+// Use Typed with RuneLength to set a custom error message.
+func RuneLengthOf[T ~string](min, max int) TypedRule[T] {
+	return Typed[T](RuneLength(min, max))
+}
+
+// MinOf returns the Min rule for values of type T.
+// Use Typed with Min to set a custom error message or to exclude the threshold.
+func MinOf[T Threshold](min T) TypedRule[T] {
+	return Typed[T](Min(min))
+}
+
+// MaxOf returns the Max rule for values of type T.
+// Use Typed with Max to set a custom error message or to exclude the threshold.
+func MaxOf[T Threshold](max T) TypedRule[T] {
+	return Typed[T](Max(max))
+}
+
+// InOf returns the In rule for values of type T.
+// Use Typed with In to set a custom error message.
+func InOf[T any](values ...T) TypedRule[T] {
+	return Typed[T](In(values...))
+}
+
+// MatchOf returns the Match rule for strings of type T.
+// Use Typed with Match to set a custom error message.
+func MatchOf[T ~string](re *regexp.Regexp) TypedRule[T] {
+	return Typed[T](Match(re))
+}
+
+type inlineTypedRule[T any] struct {
+	f  TypedRuleFunc[T]
+	fc TypedRuleWithContextFunc[T]
+}
+
+func (r *inlineTypedRule[T]) Check(value T) error {
+	if r.f == nil {
+		return r.fc(context.Background(), value)
+	}
+	return r.f(value)
+}
+
+func (r *inlineTypedRule[T]) CheckWithContext(ctx context.Context, value T) error {
+	if r.fc == nil {
+		return r.f(value)
+	}
+	return r.fc(ctx, value)
+}
+
+type typedRule[T any] struct {
+	rule Rule
+}
+
+func (r typedRule[T]) Check(value T) error {
+	return r.rule.Validate(value)
+}
+
+func (r typedRule[T]) CheckWithContext(ctx context.Context, value T) error {
+	if rc, ok := r.rule.(RuleWithContext); ok {
+		return rc.ValidateWithContext(ctx, value)
+	}
+	return r.rule.Validate(value)
+}
+
+type untypedRule[T any] struct {
+	rule TypedRule[T]
+}
+
+func (r untypedRule[T]) Validate(value interface{}) error {
+	return r.ValidateWithContext(context.Background(), value)
+}
+
+func (r untypedRule[T]) ValidateWithContext(ctx context.Context, value interface{}) error {
+	var v T
+	if value != nil {
+		var ok bool
+		if v, ok = value.(T); !ok {
+			return fmt.Errorf("cannot convert %T to %v", value, reflect.TypeOf((*T)(nil)).Elem())
+		}
+	}
+	if rc, ok := r.rule.(TypedRuleWithContext[T]); ok && ctx != nil {
+		return rc.CheckWithContext(ctx, v)
+	}
+	return r.rule.Check(v)
+}
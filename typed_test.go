@@ -0,0 +1,104 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheck(t *testing.T) {
+	hasPrefix := TypedBy(func(s string) error {
+		if !strings.HasPrefix(s, "a") {
+			return errors.New("must start with a")
+		}
+		return nil
+	})
+	positive := TypedBy(func(v int) error {
+		if v <= 0 {
+			return errors.New("must be positive")
+		}
+		return nil
+	})
+
+	assert.Nil(t, Check("abc", Typed[string](Required), hasPrefix))
+	assert.EqualError(t, Check("", Typed[string](Required), hasPrefix), "cannot be blank")
+	assert.EqualError(t, Check("xyz", Typed[string](Required), hasPrefix), "must start with a")
+	assert.Nil(t, Check("xyz", Typed[string](Skip), hasPrefix))
+	assert.Nil(t, Check(1, positive))
+	assert.EqualError(t, Check(-1, positive, Typed[int](Min(0))), "must be positive")
+
+	// validatable values are still validated after the rules
+	assert.EqualError(t, Check(String123("abc"), Typed[String123](Required)), "error 123")
+}
+
+func TestCheckWithContext(t *testing.T) {
+	k := key(1)
+	rule := TypedWithContext(func(ctx context.Context, s string) error {
+		if ctx.Value(k) != s {
+			return errors.New("unexpected value")
+		}
+		return nil
+	})
+	ctx := context.WithValue(context.Background(), k, "abc")
+
+	assert.Nil(t, CheckWithContext(ctx, "abc", rule))
+	assert.EqualError(t, CheckWithContext(ctx, "xyz", rule), "unexpected value")
+	assert.EqualError(t, Check("abc", rule), "unexpected value")
+	assert.Nil(t, CheckWithContext(ctx, "abc", Typed[string](&validateContextAbc{})))
+	assert.EqualError(t, CheckWithContext(ctx, "xyz", Typed[string](&validateContextAbc{})), "error abc")
+}
+
+func TestUntyped(t *testing.T) {
+	hasPrefix := TypedBy(func(s string) error {
+		if !strings.HasPrefix(s, "a") {
+			return errors.New("must start with a")
+		}
+		return nil
+	})
+
+	assert.Nil(t, Validate("abc", Untyped[string](hasPrefix)...))
+	assert.EqualError(t, Validate("xyz", Untyped[string](hasPrefix)...), "must start with a")
+	assert.EqualError(t, Validate(1, Untyped[string](hasPrefix)...), "cannot convert int to string")
+	assert.EqualError(t, Validate(nil, Untyped[string](hasPrefix)...), "must start with a")
+	assert.EqualError(t, Validate([]string{"abc", "xyz"}, Each(Untyped[string](hasPrefix)...)), "1: must start with a.")
+
+	m := struct {
+		Name string
+	}{"xyz"}
+	err := ValidateStruct(&m, Field(&m.Name, Untyped[string](hasPrefix)...))
+	assert.EqualError(t, err, "Name: must start with a.")
+}
+
+func TestTypedRuleConstructors(t *testing.T) {
+	type age int
+
+	assert.EqualError(t, Check("", RequiredOf[string]()), "cannot be blank")
+	assert.EqualError(t, Check("abcdef", LengthOf[string](1, 5)), "the length must be between 1 and 5")
+	assert.EqualError(t, Check([]byte("abcdef"), LengthOf[[]byte](1, 5)), "the length must be between 1 and 5")
+	assert.Nil(t, Check("\u00e4\u00f6\u00fc", RuneLengthOf[string](1, 3)))
+	assert.EqualError(t, Check(age(10), MinOf[age](18)), "must be no less than 18")
+	assert.Nil(t, Check(age(20), MinOf[age](18), MaxOf[age](130)))
+	assert.EqualError(t, Check(1.5, MaxOf(1.0)), "must be no greater than 1")
+	assert.EqualError(t, Check(uint8(3), InOf[uint8](1, 2)), "must be a valid value")
+	assert.EqualError(t, Check("123", MatchOf[string](regexp.MustCompile("^[a-z]+$"))), "must be in a valid format")
+
+	now := time.Now()
+	assert.Nil(t, Check(now, MinOf(now.Add(-time.Hour))))
+}
+
+func TestTypedField(t *testing.T) {
+	c := struct {
+		Name string
+		Age  int
+	}{"abcdef", 10}
+	err := ValidateStruct(&c,
+		TypedField(&c.Name, RequiredOf[string](), LengthOf[string](1, 5)),
+		TypedField(&c.Age, MinOf(18)),
+	)
+	assert.EqualError(t, err, "Age: must be no less than 18; Name: the length must be between 1 and 5.")
+}
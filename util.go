@@ -8,13 +8,15 @@ import (
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"time"
 )
 
 var (
-	bytesType  = reflect.TypeOf([]byte(nil))
-	valuerType = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+	bytesType    = reflect.TypeOf([]byte(nil))
+	valuerType   = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+	stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
 )
 
 // EnsureString ensures the given value is a string.
@@ -89,6 +91,93 @@ func ToFloat(value interface{}) (float64, error) {
 	return 0, fmt.Errorf("cannot convert %v to float64", v.Kind())
 }
 
+// isNumericKind checks if the given kind is an integer, unsigned integer or float kind.
+func isNumericKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// equalValues checks if two values are equal.
+// Numeric values are compared by value regardless of their kinds and types, so that a value of a custom
+// numeric type (e.g. `type Celsius float64`) is equal to a plain number with the same value, and 1 is equal
+// to 1.0 but not to 1.5. reflect.DeepEqual() is used for all other values.
+func equalValues(a, b interface{}) bool {
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if !isNumericKind(va.Kind()) || !isNumericKind(vb.Kind()) {
+		return false
+	}
+	return equalNumbers(va, vb)
+}
+
+// equalNumbers checks if two numeric values are equal by value.
+func equalNumbers(a, b reflect.Value) bool {
+	ka, kb := numericKindOf(a.Kind()), numericKindOf(b.Kind())
+	switch {
+	case ka == reflect.Float64 && kb == reflect.Float64:
+		return a.Float() == b.Float()
+	case ka == reflect.Float64:
+		return equalFloatInteger(a.Float(), b)
+	case kb == reflect.Float64:
+		return equalFloatInteger(b.Float(), a)
+	case ka == reflect.Int64 && kb == reflect.Int64:
+		return a.Int() == b.Int()
+	case ka == reflect.Int64:
+		return a.Int() >= 0 && uint64(a.Int()) == b.Uint()
+	case kb == reflect.Int64:
+		return b.Int() >= 0 && uint64(b.Int()) == a.Uint()
+	}
+	return a.Uint() == b.Uint()
+}
+
+// equalFloatInteger checks if a float is equal to a signed or unsigned integer value.
+// The float must have no fraction and be within the range of the integer before it is converted.
+func equalFloatInteger(f float64, v reflect.Value) bool {
+	if f != math.Trunc(f) {
+		return false
+	}
+	if numericKindOf(v.Kind()) == reflect.Int64 {
+		return f >= math.MinInt64 && f < -math.MinInt64 && int64(f) == v.Int()
+	}
+	return f >= 0 && f < 1<<64 && uint64(f) == v.Uint()
+}
+
+// numericKindOf returns reflect.Int64, reflect.Uint64 or reflect.Float64 for a signed integer,
+// unsigned integer or float kind respectively.
+func numericKindOf(kind reflect.Kind) reflect.Kind {
+	switch kind {
+	case reflect.Float32, reflect.Float64:
+		return reflect.Float64
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return reflect.Uint64
+	}
+	return reflect.Int64
+}
+
+// stringerParam returns the given numeric parameter converted into the type of the value being validated
+// if that type implements fmt.Stringer. This allows error messages to be rendered using the String() method
+// of custom numeric types. The parameter is returned unchanged otherwise, or if it cannot be represented by
+// the type, such as 1.5 for an integer type or -1 for an unsigned one.
+func stringerParam(param, value interface{}) interface{} {
+	pv, vv := reflect.ValueOf(param), reflect.ValueOf(value)
+	if !isNumericKind(pv.Kind()) || !isNumericKind(vv.Kind()) || !vv.Type().Implements(stringerType) {
+		return param
+	}
+	cv := pv.Convert(vv.Type())
+	bothFloats := numericKindOf(pv.Kind()) == reflect.Float64 && numericKindOf(cv.Kind()) == reflect.Float64
+	if bothFloats && math.IsInf(cv.Float(), 0) && !math.IsInf(pv.Float(), 0) || !bothFloats && !equalNumbers(pv, cv) {
+		return param
+	}
+	return cv.Interface()
+}
+
 // IsEmpty checks if a value is empty or not.
 // A value is considered empty if
 // - integer, float: zero
@@ -6,6 +6,8 @@ package validation
 
 import (
 	"database/sql"
+	"fmt"
+	"math"
 	"testing"
 	"time"
 
@@ -191,6 +193,67 @@ func TestToFloat(t *testing.T) {
 	}
 }
 
+func TestEqualValues(t *testing.T) {
+	tests := []struct {
+		tag    string
+		a, b   interface{}
+		result bool
+	}{
+		{"t1", 1, 1, true},
+		{"t2", 1, 2, false},
+		{"t3", Level(2), 2, true},
+		{"t4", Celsius(-273.15), -273.15, true},
+		{"t5", 1, 1.0, true},
+		{"t6", 1.0, 1, true},
+		{"t7", 1, 1.5, false},
+		{"t8", 1.5, 1, false},
+		{"t9", 1, uint(1), true},
+		{"t10", uint8(255), int64(255), true},
+		{"t11", -1, uint64(math.MaxUint64), false},
+		{"t12", uint64(math.MaxUint64), -1, false},
+		{"t13", uint(3), 3.0, true},
+		{"t14", -1.0, uint(1), false},
+		{"t15", 1e20, int64(math.MaxInt64), false},
+		{"t16", float64(1 << 63), uint64(1 << 63), true},
+		{"t17", math.Inf(1), uint64(math.MaxUint64), false},
+		{"t18", math.NaN(), 0, false},
+		{"t19", float32(1.5), 1.5, true},
+		{"t20", "1", 1, false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.result, equalValues(test.a, test.b), test.tag)
+	}
+}
+
+type priority uint8
+
+func (p priority) String() string {
+	return fmt.Sprintf("P%d", uint8(p))
+}
+
+func TestStringerParam(t *testing.T) {
+	tests := []struct {
+		tag          string
+		param, value interface{}
+		result       interface{}
+	}{
+		{"t1", 3, priority(1), priority(3)},
+		{"t2", 3.0, priority(1), priority(3)},
+		{"t3", 1.5, priority(1), 1.5},
+		{"t4", -1, priority(1), -1},
+		{"t5", 300, priority(1), 300},
+		{"t6", 20, Celsius(1), Celsius(20)},
+		{"t7", 1.5, Celsius(1), Celsius(1.5)},
+		{"t8", 3, Level(1), 3},
+		{"t9", "3", priority(1), "3"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.result, stringerParam(test.param, test.value), test.tag)
+	}
+}
+
 func TestIsEmpty(t *testing.T) {
 	var s1 string
 	var s2 = "a"
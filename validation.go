@@ -53,6 +53,18 @@ var (
 	// Skip is a special validation rule that indicates all rules following it should be skipped.
 	Skip = skipRule{skip: true}
 
+	// Optional is a special validation rule that indicates all rules following it should be skipped
+	// if the value being validated is empty as defined by IsEmpty: nil, a zero number, false, an empty string,
+	// array, slice or map, a zero time.Time, or a pointer to such a value. Other structs are never empty,
+	// so the rules following Optional are still applied to a zero struct. For example,
+	//
+	//	validation.Field(&p.Bio, validation.Optional, validation.Length(10, 500))
+	//
+	// Most built-in rules already consider an empty value valid, so Optional mainly makes the intent explicit
+	// and guards the rules that do not, such as custom rules created by By(). Note that rules following Optional,
+	// including Required, are not applied to an empty value, so Optional should not be combined with Required.
+	Optional = optionalRule{}
+
 	validatableType            = reflect.TypeOf((*Validatable)(nil)).Elem()
 	validatableWithContextType = reflect.TypeOf((*ValidatableWithContext)(nil)).Elem()
 )
@@ -66,12 +78,20 @@ var (
 //  3. If the value being validated is a map/slice/array, and the element type implements `Validatable`,
 //     for each element call the element value's `Validate()`. Return with the validation result.
 func Validate(value interface{}, rules ...Rule) error {
+	rules = expandRuleSets(rules)
+	if ok, err := validateFast(value, rules); ok {
+		return err
+	}
+
 	for _, rule := range rules {
 		if s, ok := rule.(skipRule); ok && s.skip {
 			return nil
 		}
+		if _, ok := rule.(optionalRule); ok && isEmptyValue(value) {
+			return nil
+		}
 		if err := rule.Validate(value); err != nil {
-			return err
+			return withValue(err, value)
 		}
 	}
 
@@ -113,17 +133,32 @@ func Validate(value interface{}, rules ...Rule) error {
 //     for each element call the element value's `ValidateWithContext()`. Return with the validation result.
 //  5. If the value being validated is a map/slice/array, and the element type implements `Validatable`,
 //     for each element call the element value's `Validate()`. Return with the validation result.
+//
+// If the context carries error messages set by WithMessages, they replace the default messages of the errors.
 func ValidateWithContext(ctx context.Context, value interface{}, rules ...Rule) error {
+	return applyMessages(ctx, validateWithContext(ctx, value, rules...))
+}
+
+// validateWithContext performs the validation steps of ValidateWithContext.
+func validateWithContext(ctx context.Context, value interface{}, rules ...Rule) error {
+	rules = expandRuleSets(rules)
+	if ok, err := validateFast(value, rules); ok {
+		return err
+	}
+
 	for _, rule := range rules {
 		if s, ok := rule.(skipRule); ok && s.skip {
 			return nil
 		}
+		if _, ok := rule.(optionalRule); ok && isEmptyValue(value) {
+			return nil
+		}
 		if rc, ok := rule.(RuleWithContext); ok {
 			if err := rc.ValidateWithContext(ctx, value); err != nil {
-				return err
+				return withValue(err, value)
 			}
 		} else if err := rule.Validate(value); err != nil {
-			return err
+			return withValue(err, value)
 		}
 	}
 
@@ -133,10 +168,18 @@ func ValidateWithContext(ctx context.Context, value interface{}, rules ...Rule)
 	}
 
 	if v, ok := value.(ValidatableWithContext); ok {
+		ctx, ok := enterPointer(ctx, value)
+		if !ok {
+			// the value references back to a value being validated
+			return nil
+		}
 		return v.ValidateWithContext(ctx)
 	}
 
 	if v, ok := value.(Validatable); ok {
+		if _, ok := enterPointer(ctx, value); !ok {
+			return nil
+		}
 		return v.Validate()
 	}
 
@@ -156,7 +199,7 @@ func ValidateWithContext(ctx context.Context, value interface{}, rules ...Rule)
 			return validateSlice(rv)
 		}
 	case reflect.Ptr, reflect.Interface:
-		return ValidateWithContext(ctx, rv.Elem().Interface())
+		return validateWithContext(ctx, rv.Elem().Interface())
 	}
 
 	return nil
@@ -168,7 +211,9 @@ func validateMap(rv reflect.Value) error {
 	for _, key := range rv.MapKeys() {
 		if mv := rv.MapIndex(key).Interface(); mv != nil {
 			if err := mv.(Validatable).Validate(); err != nil {
-				errs[fmt.Sprintf("%v", key.Interface())] = err
+				if !errs.add(fmt.Sprintf("%v", key.Interface()), err) {
+					break
+				}
 			}
 		}
 	}
@@ -182,9 +227,15 @@ func validateMap(rv reflect.Value) error {
 func validateMapWithContext(ctx context.Context, rv reflect.Value) error {
 	errs := Errors{}
 	for _, key := range rv.MapKeys() {
-		if mv := rv.MapIndex(key).Interface(); mv != nil {
+		mv := rv.MapIndex(key).Interface()
+		if mv == nil {
+			continue
+		}
+		if ctx, ok := enterPointer(ctx, mv); ok {
 			if err := mv.(ValidatableWithContext).ValidateWithContext(ctx); err != nil {
-				errs[fmt.Sprintf("%v", key.Interface())] = err
+				if !errs.add(fmt.Sprintf("%v", key.Interface()), err) {
+					break
+				}
 			}
 		}
 	}
@@ -205,7 +256,9 @@ func validateSlice(rv reflect.Value) error {
 		}
 		if ev := v.Interface(); ev != nil {
 			if err := ev.(Validatable).Validate(); err != nil {
-				errs[strconv.Itoa(i)] = err
+				if !errs.add(strconv.Itoa(i), err) {
+					break
+				}
 			}
 		}
 	}
@@ -224,9 +277,15 @@ func validateSliceWithContext(ctx context.Context, rv reflect.Value) error {
 		if v.Kind() == reflect.Ptr && v.IsNil() {
 			continue
 		}
-		if ev := v.Interface(); ev != nil {
+		ev := v.Interface()
+		if ev == nil {
+			continue
+		}
+		if ctx, ok := enterPointer(ctx, ev); ok {
 			if err := ev.(ValidatableWithContext).ValidateWithContext(ctx); err != nil {
-				errs[strconv.Itoa(i)] = err
+				if !errs.add(strconv.Itoa(i), err) {
+					break
+				}
 			}
 		}
 	}
@@ -250,6 +309,18 @@ func (r skipRule) When(condition bool) skipRule {
 	return r
 }
 
+type optionalRule struct{}
+
+func (r optionalRule) Validate(interface{}) error {
+	return nil
+}
+
+// isEmptyValue checks if a value is a nil pointer or an empty value.
+func isEmptyValue(value interface{}) bool {
+	value, isNil := Indirect(value)
+	return isNil || IsEmpty(value)
+}
+
 type inlineRule struct {
 	f  RuleFunc
 	fc RuleWithContextFunc
@@ -278,3 +349,20 @@ func By(f RuleFunc) Rule {
 func WithContext(f RuleWithContextFunc) Rule {
 	return &inlineRule{fc: f}
 }
+
+// validateRules validates a value with the given context, or without a context if it is nil.
+// It is shared by the validations of struct fields, elements and keys, and recovers from a panic
+// as ErrPanic if RecoverPanics is enabled.
+func validateRules(ctx context.Context, value interface{}, rules []Rule) (err error) {
+	if RecoverPanics {
+		defer func() {
+			if p := recover(); p != nil {
+				err = ErrPanic.SetParams(map[string]interface{}{"panic": p})
+			}
+		}()
+	}
+	if ctx == nil {
+		return Validate(value, rules...)
+	}
+	return ValidateWithContext(ctx, value, rules...)
+}
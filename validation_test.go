@@ -9,6 +9,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -133,6 +134,62 @@ func Test_skipRule_Validate(t *testing.T) {
 	assert.Nil(t, Skip.Validate(100))
 }
 
+func TestOptional(t *testing.T) {
+	var sp *string
+	bio := ""
+	strict := By(func(value interface{}) error {
+		if s, _ := value.(string); len(s) < 3 {
+			return errors.New("too short")
+		}
+		return nil
+	})
+	ctx := context.Background()
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		rules []Rule
+		err   string
+	}{
+		{"t1", "", []Rule{Optional, strict}, ""},
+		{"t2", "ab", []Rule{Optional, strict}, "too short"},
+		{"t3", "abc", []Rule{Optional, strict}, ""},
+		{"t4", "", []Rule{strict}, "too short"},
+		{"t5", sp, []Rule{Optional, strict}, ""},
+		{"t6", &bio, []Rule{Optional, strict}, ""},
+		{"t7", 0, []Rule{Optional, By(func(interface{}) error { return errors.New("called") })}, ""},
+		{"t8", "", []Rule{Optional, Required}, ""},
+		{"t9", "", []Rule{Required, Optional}, "cannot be blank"},
+		{"t10", "ab", []Rule{strict, Optional}, "too short"},
+		{"t11", "", []Rule{Optional, Length(3, 500)}, ""},
+	}
+
+	for _, test := range tests {
+		err := Validate(test.value, test.rules...)
+		assertError(t, test.err, err, test.tag)
+		err = ValidateWithContext(ctx, test.value, test.rules...)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	p := struct {
+		Bio  string
+		Name string
+	}{}
+	err := ValidateStruct(&p,
+		Field(&p.Bio, Optional, strict),
+		Field(&p.Name, strict),
+	)
+	assertError(t, "Name: too short.", err, "t12")
+	assert.Nil(t, Optional.Validate(100), "t13")
+
+	type address struct{ Street string }
+	called := By(func(interface{}) error { return errors.New("called") })
+	var addr address
+	assertError(t, "called", Validate(addr, Optional, called), "t14")
+	assertError(t, "", Validate((*address)(nil), Optional, called), "t15")
+	assertError(t, "", Validate(time.Time{}, Optional, called), "t16")
+}
+
 func assertError(t *testing.T, expected string, err error, tag string) {
 	if expected == "" {
 		assert.NoError(t, err, tag)
@@ -0,0 +1,56 @@
+package validation
+
+import (
+	"context"
+	"net/url"
+)
+
+// ValidateValues validates url.Values, such as the parsed query string of a request, by checking the specified keys
+// against the corresponding validation rules. For example,
+//
+//	err := validation.ValidateValues(r.URL.Query(),
+//	    validation.Key("page", validation.Required, is.Int),
+//	    validation.Key("limit", is.Int),
+//	)
+//
+// By default, only the first value of a key is validated. Call AllValues() on a key to validate each of its values,
+// in which case the errors are indexed by the positions of the values. A missing key is validated as an empty string,
+// so use the Required rule to make sure a value is present, or call Optional() to skip the rules of a missing key.
+// Keys not specified are ignored. The errors are indexed by the keys.
+func ValidateValues(values url.Values, keys ...*KeyRules) error {
+	return ValidateValuesWithContext(context.Background(), values, keys...)
+}
+
+// ValidateValuesWithContext validates url.Values with the given context.
+// Please refer to ValidateValues for the detailed instructions on how to use this function.
+func ValidateValuesWithContext(ctx context.Context, values url.Values, keys ...*KeyRules) error {
+	errs := Errors{}
+	for _, kr := range keys {
+		var err error
+		key, ok := kr.key.(string)
+		if !ok {
+			err = ErrKeyWrongType
+		} else if vs, found := values[key]; !found || len(vs) == 0 {
+			if !kr.optional {
+				err = validateRules(ctx, "", kr.rules)
+			}
+		} else if kr.allValues {
+			err = validateRules(ctx, vs, []Rule{Each(kr.rules...)})
+		} else {
+			err = validateRules(ctx, vs[0], kr.rules)
+		}
+		if err != nil {
+			if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
+				return err
+			}
+			if !errs.add(getErrorKeyName(kr.key), err) {
+				break
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
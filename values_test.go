@@ -0,0 +1,77 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateValues(t *testing.T) {
+	values := url.Values{
+		"page":  {"2"},
+		"limit": {"500", "10"},
+		"tag":   {"go", "", "x"},
+		"empty": {},
+	}
+
+	tests := []struct {
+		tag  string
+		keys []*KeyRules
+		err  string
+	}{
+		{"t1", []*KeyRules{Key("page", Required, Length(1, 3))}, ""},
+		{"t2", []*KeyRules{Key("limit", Length(1, 2))}, "limit: the length must be between 1 and 2."},
+		{"t3", []*KeyRules{Key("limit", Length(1, 2)).AllValues()}, "limit: (0: the length must be between 1 and 2.)."},
+		{"t4", []*KeyRules{Key("tag", Required, Length(2, 5)).AllValues()}, "tag: (1: cannot be blank; 2: the length must be between 2 and 5.)."},
+		{"t5", []*KeyRules{Key("missing", Required)}, "missing: cannot be blank."},
+		{"t6", []*KeyRules{Key("missing", Length(1, 2))}, ""},
+		{"t7", []*KeyRules{Key("missing", Required).Optional()}, ""},
+		{"t8", []*KeyRules{Key("empty", Required)}, "empty: cannot be blank."},
+		{"t9", []*KeyRules{Key(1, Required)}, "1: key not the correct type."},
+		{"t10", []*KeyRules{Key("page", Length(2, 3)), Key("missing", Required)}, "missing: cannot be blank; page: the length must be between 2 and 3."},
+		{"t11", nil, ""},
+	}
+
+	for _, test := range tests {
+		err := ValidateValues(values, test.keys...)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	assert.Nil(t, ValidateValues(nil, Key("page", Length(1, 2))))
+
+	err := ValidateValues(values, Key("page", By(func(interface{}) error {
+		return NewInternalError(errors.New("internal"))
+	})))
+	assertError(t, "internal", err, "t12")
+}
+
+func TestValidateValuesWithContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), valuesContextKey{}, "2")
+	rule := WithContext(func(ctx context.Context, value interface{}) error {
+		if ctx.Value(valuesContextKey{}) != value {
+			return errors.New("unexpected value")
+		}
+		return nil
+	})
+
+	assert.Nil(t, ValidateValuesWithContext(ctx, url.Values{"page": {"2"}}, Key("page", rule)))
+	err := ValidateValuesWithContext(ctx, url.Values{"page": {"3"}}, Key("page", rule))
+	assertError(t, "page: unexpected value.", err, "t1")
+}
+
+func TestValidateValues_RecoverPanics(t *testing.T) {
+	rule := By(func(interface{}) error {
+		panic("boom")
+	})
+
+	RecoverPanics = true
+	defer func() { RecoverPanics = false }()
+
+	err := ValidateValues(url.Values{"page": {"2"}}, Key("page", rule), Key("limit", Required))
+	assert.EqualError(t, err, "limit: cannot be blank; page: internal validation error.")
+}
+
+type valuesContextKey struct{}
@@ -0,0 +1,199 @@
+package validation
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// WalkRule is a validation rule that validates the values found at the given paths of a tree of maps and slices.
+type WalkRule struct {
+	rules map[string][]Rule
+}
+
+// walkMatch is a value found at a path of a tree.
+type walkMatch struct {
+	path  []string
+	value interface{}
+}
+
+// Walk returns a validation rule that traverses a tree of nested maps and slices, such as a map[string]interface{}
+// decoded from JSON or YAML, and validates the values found at the given dotted paths with the corresponding rules.
+// A path segment matches a map key or a slice index, and the wildcard "*" matches every key or index at its level.
+// For example,
+//
+//	err := validation.Validate(config, validation.Walk(map[string][]validation.Rule{
+//	    "name":         {validation.Required},
+//	    "items.*.name": {validation.Required, validation.Length(1, 50)},
+//	    "items.*.tags": {validation.Each(is.LowerCase)},
+//	}))
+//
+// A path without wildcards is validated even if the value does not exist, in which case nil is validated, so that
+// Required can be used to make sure a value is present. A wildcard only matches the existing keys or indices.
+// The errors are returned as nested Errors following the structure of the tree, so that ToMultiMap reports them
+// by their dotted paths. The empty path refers to the tree itself. Map keys containing dots cannot be matched.
+// A nil value is considered valid.
+func Walk(rulesByPath map[string][]Rule) WalkRule {
+	return WalkRule{rules: rulesByPath}
+}
+
+// Validate checks if the given value is valid or not.
+func (r WalkRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(context.Background(), value)
+}
+
+// ValidateWithContext checks if the given value is valid or not.
+func (r WalkRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	if v, isNil := Indirect(value); isNil || v == nil {
+		return nil
+	}
+
+	paths := make([]string, 0, len(r.rules))
+	for path := range r.rules {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	errs := Errors{}
+	for _, path := range paths {
+		var segments []string
+		if path != "" {
+			segments = strings.Split(path, ".")
+		}
+		for _, m := range walkPath(reflect.ValueOf(value), segments, nil) {
+			var err error
+			if ctx == nil {
+				err = Validate(m.value, r.rules[path]...)
+			} else {
+				err = ValidateWithContext(ctx, m.value, r.rules[path]...)
+			}
+			if err == nil {
+				continue
+			}
+			if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
+				return err
+			}
+			addWalkError(errs, m.path, err)
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	if err, ok := errs[""]; ok && len(errs) == 1 {
+		return err
+	}
+	return errs
+}
+
+// walkPath finds the values at the given path segments below the given value.
+func walkPath(v reflect.Value, segments []string, path []string) []walkMatch {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	if len(segments) == 0 {
+		var value interface{}
+		if v.IsValid() && v.CanInterface() {
+			value = v.Interface()
+		}
+		return []walkMatch{{path: path, value: value}}
+	}
+
+	seg, rest := segments[0], segments[1:]
+	if seg == "*" {
+		var matches []walkMatch
+		switch v.Kind() {
+		case reflect.Map:
+			keys := v.MapKeys()
+			names := make([]string, len(keys))
+			for i, key := range keys {
+				names[i] = getErrorKeyName(key.Interface())
+			}
+			sort.Sort(walkKeys{names, keys})
+			for i, key := range keys {
+				matches = append(matches, walkPath(v.MapIndex(key), rest, appendPath(path, names[i]))...)
+			}
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < v.Len(); i++ {
+				matches = append(matches, walkPath(v.Index(i), rest, appendPath(path, strconv.Itoa(i)))...)
+			}
+		}
+		return matches
+	}
+
+	return walkPath(walkChild(v, seg), rest, appendPath(path, seg))
+}
+
+// walkChild returns the value of a map key or a slice index, or an invalid value if it does not exist.
+func walkChild(v reflect.Value, name string) reflect.Value {
+	switch v.Kind() {
+	case reflect.Map:
+		if kt := v.Type().Key(); kt.Kind() == reflect.String {
+			return v.MapIndex(reflect.ValueOf(name).Convert(kt))
+		}
+		for _, key := range v.MapKeys() {
+			if getErrorKeyName(key.Interface()) == name {
+				return v.MapIndex(key)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if i, err := strconv.Atoi(name); err == nil && i >= 0 && i < v.Len() {
+			return v.Index(i)
+		}
+	}
+	return reflect.Value{}
+}
+
+func appendPath(path []string, name string) []string {
+	return append(path[:len(path):len(path)], name)
+}
+
+// addWalkError adds an error found at the given path to the nested Errors. An error found at a path which also
+// has nested errors is indexed by an empty key.
+// The nested Errors may have been returned by a rule, so they are copied rather than modified.
+func addWalkError(errs Errors, path []string, err error) {
+	if len(path) == 0 {
+		errs[""] = err
+		return
+	}
+	name := path[0]
+	nested, ok := errs[name].(Errors)
+	if len(path) == 1 && !ok {
+		errs[name] = err
+		return
+	}
+	if ok {
+		nested = copyErrors(nested)
+	} else {
+		nested = Errors{}
+		if existing, exists := errs[name]; exists {
+			nested[""] = existing
+		}
+	}
+	errs[name] = nested
+	addWalkError(nested, path[1:], err)
+}
+
+// copyErrors returns a shallow copy of the Errors.
+func copyErrors(es Errors) Errors {
+	res := make(Errors, len(es)+1)
+	for key, err := range es {
+		res[key] = err
+	}
+	return res
+}
+
+// walkKeys sorts map keys by their names.
+type walkKeys struct {
+	names []string
+	keys  []reflect.Value
+}
+
+func (k walkKeys) Len() int           { return len(k.names) }
+func (k walkKeys) Less(i, j int) bool { return k.names[i] < k.names[j] }
+func (k walkKeys) Swap(i, j int) {
+	k.names[i], k.names[j] = k.names[j], k.names[i]
+	k.keys[i], k.keys[j] = k.keys[j], k.keys[i]
+}
@@ -0,0 +1,92 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWalk(t *testing.T) {
+	config := map[string]interface{}{
+		"name": "app",
+		"items": []interface{}{
+			map[string]interface{}{"name": "a", "tags": []interface{}{"x", ""}},
+			map[string]interface{}{"name": "", "size": 10},
+			map[string]interface{}{"tags": []string{"y"}},
+		},
+		"limits": map[string]interface{}{
+			"cpu":    2,
+			"memory": -1,
+		},
+		"ports": map[int]interface{}{80: "http", 443: ""},
+	}
+
+	tests := []struct {
+		tag   string
+		rules map[string][]Rule
+		err   string
+	}{
+		{"t1", map[string][]Rule{"name": {Required}}, ""},
+		{"t2", map[string][]Rule{"version": {Required}}, "version: cannot be blank."},
+		{"t3", map[string][]Rule{"items.*.name": {Required}}, "items: (1: (name: cannot be blank.); 2: (name: cannot be blank.).)."},
+		{"t4", map[string][]Rule{"items.*.tags": {Optional, Each(Required)}}, "items: (0: (tags: (1: cannot be blank.).).)."},
+		{"t5", map[string][]Rule{"items.*.tags.*": {Required}}, "items: (0: (tags: (1: cannot be blank.).).)."},
+		{"t6", map[string][]Rule{"limits.*": {Min(0)}}, "limits: (memory: must be no less than 0.)."},
+		{"t7", map[string][]Rule{"items.1.size": {Max(5)}, "items.0.name": {Length(2, 5)}}, "items: (0: (name: the length must be between 2 and 5.); 1: (size: must be no greater than 5.).)."},
+		{"t8", map[string][]Rule{"items.5.name": {Required}}, "items: (5: (name: cannot be blank.).)."},
+		{"t9", map[string][]Rule{"name.first": {Required}}, "name: (first: cannot be blank.)."},
+		{"t10", map[string][]Rule{"ports.*": {Required}}, "ports: (443: cannot be blank.)."},
+		{"t11", map[string][]Rule{"ports.80": {In("https")}}, "ports: (80: must be a valid value.)."},
+		{"t12", map[string][]Rule{"items": {Length(5, 10)}, "items.*.name": {Required}}, "items: (: the length must be between 5 and 10; 1: (name: cannot be blank.); 2: (name: cannot be blank.).)."},
+		{"t13", map[string][]Rule{"": {Length(1, 2)}}, "the length must be between 1 and 2"},
+		{"t14", map[string][]Rule{"": {Length(1, 2)}, "name": {In("x")}}, ": the length must be between 1 and 2; name: must be a valid value."},
+		{"t15", map[string][]Rule{"missing.*.name": {Required}}, ""},
+		{"t16", map[string][]Rule{}, ""},
+	}
+
+	for _, test := range tests {
+		err := Validate(config, Walk(test.rules))
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := Validate(config, Walk(map[string][]Rule{"items.*.name": {Required}, "limits.*": {Min(0)}}))
+	if es, ok := err.(Errors); assert.True(t, ok) {
+		assert.Equal(t, map[string][]string{
+			"items.1.name":  {"cannot be blank"},
+			"items.2.name":  {"cannot be blank"},
+			"limits.memory": {"must be no less than 0"},
+		}, es.ToMultiMap())
+	}
+
+	// the Errors returned by a rule are not modified when merging the errors of nested paths
+	cached := Errors{"memory": ErrRequired}
+	err = Validate(config, Walk(map[string][]Rule{
+		"limits":     {By(func(interface{}) error { return cached })},
+		"limits.cpu": {Max(1)},
+	}))
+	assertError(t, "limits: (cpu: must be no greater than 1; memory: cannot be blank.).", err, "t17")
+	assert.Equal(t, Errors{"memory": ErrRequired}, cached)
+
+	var nilMap map[string]interface{}
+	assert.Nil(t, Validate(nilMap, Walk(map[string][]Rule{"name": {Required}})))
+	assert.Nil(t, Validate(nil, Walk(map[string][]Rule{"name": {Required}})))
+}
+
+func TestWalk_Context(t *testing.T) {
+	rule := WithContext(func(ctx context.Context, value interface{}) error {
+		if ctx.Value(contains) != value {
+			return errors.New("unexpected value")
+		}
+		return nil
+	})
+	ctx := context.WithValue(context.Background(), contains, "abc")
+	tree := map[string]interface{}{"a": []interface{}{"abc", "xyz", "internal"}}
+
+	err := ValidateWithContext(ctx, tree, Walk(map[string][]Rule{"a.*": {rule}}))
+	assertError(t, "a: (1: unexpected value; 2: unexpected value.).", err, "t1")
+
+	err = ValidateWithContext(ctx, tree, Walk(map[string][]Rule{"a.*": {&validateInternalError{}}}))
+	assertError(t, "error internal", err, "t2")
+}
@@ -11,11 +11,29 @@ func When(condition bool, rules ...Rule) WhenRule {
 	}
 }
 
+// WhenContext returns a validation rule that executes the given list of rules when the condition function
+// returns true for the context of the validation. This allows validation to depend on request-scoped information
+// carried by the context, such as feature flags or the role of the current user. For example,
+//
+//	validation.ValidateWithContext(ctx, value,
+//	    validation.WhenContext(func(ctx context.Context) bool { return !isAdmin(ctx) }, validation.Required),
+//	)
+//
+// When validating without a context, the condition function is called with context.Background().
+func WhenContext(condition func(ctx context.Context) bool, rules ...Rule) WhenRule {
+	return WhenRule{
+		contextCondition: condition,
+		rules:            rules,
+		elseRules:        []Rule{},
+	}
+}
+
 // WhenRule is a validation rule that executes the given list of rules when the condition is true.
 type WhenRule struct {
-	condition bool
-	rules     []Rule
-	elseRules []Rule
+	condition        bool
+	contextCondition func(ctx context.Context) bool
+	rules            []Rule
+	elseRules        []Rule
 }
 
 // Validate checks if the condition is true and if so, it validates the value using the specified rules.
@@ -25,7 +43,7 @@ func (r WhenRule) Validate(value interface{}) error {
 
 // ValidateWithContext checks if the condition is true and if so, it validates the value using the specified rules.
 func (r WhenRule) ValidateWithContext(ctx context.Context, value interface{}) error {
-	if r.condition {
+	if r.conditionMet(ctx) {
 		if ctx == nil {
 			return Validate(value, r.rules...)
 		}
@@ -43,3 +61,74 @@ func (r WhenRule) Else(rules ...Rule) WhenRule {
 	r.elseRules = rules
 	return r
 }
+
+// conditionMet evaluates the condition of the rule with the given context.
+func (r WhenRule) conditionMet(ctx context.Context) bool {
+	if r.contextCondition == nil {
+		return r.condition
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return r.contextCondition(ctx)
+}
+
+// Switch returns a validation rule that executes the rules of the first case whose condition is true,
+// or the default rules if no condition is true. This is cleaner than nested When/Else rules when the rules
+// of a field depend on an enum value. For example,
+//
+//	validation.Field(&p.Account,
+//	    validation.Switch().
+//	        Case(p.Method == "card", validation.Required, is.CreditCard).
+//	        Case(p.Method == "paypal", validation.Required, is.EmailFormat).
+//	        Default(validation.Empty),
+//	)
+func Switch() SwitchRule {
+	return SwitchRule{}
+}
+
+// SwitchRule is a validation rule that executes the rules of the first case whose condition is true.
+type SwitchRule struct {
+	cases        []switchCase
+	defaultRules []Rule
+}
+
+type switchCase struct {
+	condition bool
+	rules     []Rule
+}
+
+// Case returns a copy of the rule with a case that executes the given list of rules when the condition is true
+// and the conditions of the previous cases are false.
+func (r SwitchRule) Case(condition bool, rules ...Rule) SwitchRule {
+	r.cases = append(r.cases[:len(r.cases):len(r.cases)], switchCase{condition: condition, rules: rules})
+	return r
+}
+
+// Default returns a copy of the rule that executes the given list of rules when the conditions of all cases are false.
+func (r SwitchRule) Default(rules ...Rule) SwitchRule {
+	r.defaultRules = rules
+	return r
+}
+
+// Validate validates the value using the rules of the first case whose condition is true, or the default rules.
+func (r SwitchRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(context.Background(), value)
+}
+
+// ValidateWithContext validates the value using the rules of the first case whose condition is true,
+// or the default rules.
+func (r SwitchRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	rules := r.defaultRules
+	for _, c := range r.cases {
+		if c.condition {
+			rules = c.rules
+			break
+		}
+	}
+
+	if ctx == nil {
+		return Validate(value, rules...)
+	}
+	return ValidateWithContext(ctx, value, rules...)
+}
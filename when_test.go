@@ -98,3 +98,105 @@ func TestWhenWithContext(t *testing.T) {
 		assertError(t, test.err, err, test.tag)
 	}
 }
+
+type roleKey struct{}
+
+func TestWhenContext(t *testing.T) {
+	isUser := func(ctx context.Context) bool {
+		return ctx.Value(roleKey{}) != "admin"
+	}
+	admin := context.WithValue(context.Background(), roleKey{}, "admin")
+	user := context.WithValue(context.Background(), roleKey{}, "user")
+
+	tests := []struct {
+		tag   string
+		ctx   context.Context
+		rule  Rule
+		value interface{}
+		err   string
+	}{
+		{"t1", user, WhenContext(isUser, Required), "", "cannot be blank"},
+		{"t2", admin, WhenContext(isUser, Required), "", ""},
+		{"t3", user, WhenContext(isUser, Required), "abc", ""},
+		{"t4", admin, WhenContext(isUser, Required).Else(Length(5, 10)), "abc", "the length must be between 5 and 10"},
+		{"t5", user, WhenContext(isUser, Required).Else(Length(5, 10)), "abc", ""},
+		{"t6", context.Background(), WhenContext(isUser, Required), "", "cannot be blank"},
+	}
+
+	for _, test := range tests {
+		err := ValidateWithContext(test.ctx, test.value, test.rule)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	// without a context, the condition is evaluated with context.Background()
+	err := Validate("", WhenContext(isUser, Required))
+	assertError(t, "cannot be blank", err, "t7")
+	err = WhenContext(isUser, Required).ValidateWithContext(nil, "") //nolint:staticcheck
+	assertError(t, "cannot be blank", err, "t8")
+}
+
+func TestSwitch(t *testing.T) {
+	abcRule := NewStringRule(abcValidation, "wrong_abc")
+	validateMeRule := NewStringRule(validateMe, "wrong_me")
+
+	rule := func(method string) SwitchRule {
+		return Switch().
+			Case(method == "abc", Required, abcRule).
+			Case(method == "me", validateMeRule).
+			Case(method == "any", Required, validateMeRule).
+			Default(Empty)
+	}
+
+	tests := []struct {
+		tag    string
+		method string
+		value  interface{}
+		err    string
+	}{
+		{"t1", "abc", "abc", ""},
+		{"t2", "abc", "me", "wrong_abc"},
+		{"t3", "abc", "", "cannot be blank"},
+		{"t4", "me", "me", ""},
+		{"t5", "me", "abc", "wrong_me"},
+		{"t6", "me", "", ""},
+		{"t7", "any", "", "cannot be blank"},
+		{"t8", "other", "", ""},
+		{"t9", "other", "abc", "must be blank"},
+	}
+
+	for _, test := range tests {
+		err := Validate(test.value, rule(test.method))
+		assertError(t, test.err, err, test.tag)
+	}
+
+	// only the first matching case is applied
+	r := Switch().Case(true, abcRule).Case(true, validateMeRule)
+	assertError(t, "wrong_abc", Validate("me", r), "t10")
+	assertError(t, "", Validate("abc", r), "t11")
+
+	// without cases and default, no rule is applied
+	assertError(t, "", Validate("abc", Switch()), "t12")
+
+	// cases added to a copy do not affect the original rule or other copies
+	base := Switch().Case(false, validateMeRule)
+	a := base.Case(true, abcRule)
+	b := base.Case(true, validateMeRule)
+	assertError(t, "wrong_abc", Validate("me", a), "t13")
+	assertError(t, "", Validate("me", b), "t14")
+	assertError(t, "", Validate("xyz", base), "t15")
+}
+
+func TestSwitchWithContext(t *testing.T) {
+	rule := WithContext(func(ctx context.Context, value interface{}) error {
+		if ctx.Value(contains) == value {
+			return nil
+		}
+		return errors.New("unexpected value")
+	})
+	ctx := context.WithValue(context.Background(), contains, "abc")
+
+	assertError(t, "", ValidateWithContext(ctx, "abc", Switch().Case(true, rule)), "t1")
+	assertError(t, "unexpected value", ValidateWithContext(ctx, "xyz", Switch().Case(false).Default(rule)), "t2")
+	err := Switch().Case(false, rule).ValidateWithContext(nil, "xyz") //nolint:staticcheck
+	assertError(t, "", err, "t3")
+}
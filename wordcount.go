@@ -0,0 +1,70 @@
+package validation
+
+import "strings"
+
+var (
+	// ErrTooManyWords is the error that returns when a string contains more words than the maximum.
+	ErrTooManyWords = NewError("validation_too_many_words", "must contain no more than {{.max}} words")
+	// ErrTooFewWords is the error that returns when a string contains fewer words than the minimum.
+	ErrTooFewWords = NewError("validation_too_few_words", "must contain at least {{.min}} words")
+	// ErrWordCountOutOfRange is the error that returns when the number of words in a string is out of the specified range.
+	ErrWordCountOutOfRange = NewError("validation_word_count_out_of_range", "must contain between {{.min}} and {{.max}} words")
+)
+
+// WordCountRule is a validation rule that checks if the number of words in a string is within the specified range.
+type WordCountRule struct {
+	min, max int
+	err      Error
+}
+
+// WordCount returns a validation rule that checks if the number of words in a string is within the specified range,
+// e.g. for summaries limited by words rather than characters. Words are separated by one or more Unicode white space
+// characters, and leading and trailing white space is ignored, so a string consisting of white space only has no words.
+// If max is 0, it means there is no upper bound for the number of words.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func WordCount(min, max int) WordCountRule {
+	var err Error
+	switch {
+	case min > 0 && max > 0:
+		err = ErrWordCountOutOfRange
+	case max > 0:
+		err = ErrTooManyWords
+	default:
+		err = ErrTooFewWords
+	}
+	return WordCountRule{
+		min: min,
+		max: max,
+		err: err.SetParams(map[string]interface{}{"min": min, "max": max}),
+	}
+}
+
+// Error sets the error message for the rule.
+func (r WordCountRule) Error(message string) WordCountRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r WordCountRule) ErrorObject(err Error) WordCountRule {
+	r.err = err
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r WordCountRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	if n := len(strings.Fields(str)); n < r.min || r.max > 0 && n > r.max {
+		return r.err
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordCount(t *testing.T) {
+	var s *string
+	tests := []struct {
+		tag      string
+		min, max int
+		value    interface{}
+		err      string
+	}{
+		{"t1", 2, 3, "hello world", ""},
+		{"t2", 2, 3, "  hello   big \t wide\n", ""},
+		{"t3", 2, 3, "one two three four", "must contain between 2 and 3 words"},
+		{"t4", 2, 3, "hello", "must contain between 2 and 3 words"},
+		{"t5", 2, 3, "   ", "must contain between 2 and 3 words"},
+		{"t6", 2, 3, "hello\u00a0world\u3000again", ""},
+		{"t7", 2, 3, "well-known fact", ""},
+		{"t8", 0, 2, "a b c", "must contain no more than 2 words"},
+		{"t9", 2, 0, "a", "must contain at least 2 words"},
+		{"t10", 2, 0, "a b c d e", ""},
+		{"t11", 2, 3, "", ""},
+		{"t12", 2, 3, s, ""},
+		{"t13", 2, 3, []byte("hello world"), ""},
+		{"t14", 2, 3, 123, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		err := WordCount(test.min, test.max).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func Test_WordCountRule_Error(t *testing.T) {
+	r := WordCount(10, 100)
+	assert.Equal(t, "must contain between {{.min}} and {{.max}} words", r.err.Message())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestWordCountRule_ErrorObject(t *testing.T) {
+	r := WordCount(10, 100)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}